@@ -1,10 +1,21 @@
+// Command relayd runs a public libp2p node that relays connections between
+// ptmux peers that can't reach each other directly, and advertises itself on
+// the DHT so that peers behind NATs find it.
+//
+//	relayd -listen /ip4/0.0.0.0/tcp/4001 -allow 12D3KooW... -metrics :9090
 package main
 
 import (
 	"context"
+	"errors"
+	"flag"
 	"fmt"
 	"io/ioutil"
+	"net/http"
 	"os"
+	"os/signal"
+	"strings"
+	"syscall"
 	"time"
 
 	libp2p "github.com/libp2p/go-libp2p"
@@ -12,49 +23,221 @@ import (
 	"github.com/libp2p/go-libp2p-core/discovery"
 	"github.com/libp2p/go-libp2p-core/host"
 	"github.com/libp2p/go-libp2p-core/network"
+	"github.com/libp2p/go-libp2p-core/peer"
 	"github.com/libp2p/go-libp2p-core/routing"
 	gdiscovery "github.com/libp2p/go-libp2p-discovery"
 	dht "github.com/libp2p/go-libp2p-kad-dht"
 	"github.com/libp2p/go-libp2p/p2p/host/autorelay"
+	relayv2 "github.com/libp2p/go-libp2p/p2p/protocol/circuitv2/relay"
 )
 
+// defaultListenAddrs are the addresses relayd listens on unless -listen is
+// given, over TCP, QUIC and websockets.
+var defaultListenAddrs = []string{
+	"/ip4/0.0.0.0/tcp/4001",
+	"/ip6/::/tcp/4001",
+	"/ip4/0.0.0.0/udp/4001/quic",
+	"/ip6/::/udp/4001/quic",
+	"/ip4/0.0.0.0/tcp/4002/ws",
+	"/ip6/::/tcp/4002/ws",
+}
+
+// config is the configuration of relayd given by its flags.
+type config struct {
+	listenAddrs  []string
+	identityPath string
+	dhtMode      string
+	allow        []string
+	metricsAddr  string
+
+	// allowPeers are the peers parsed from allow.
+	allowPeers map[peer.ID]struct{}
+
+	maxReservations        int
+	maxReservationsPerPeer int
+	maxReservationsPerIP   int
+	maxCircuits            int
+	reservationTTL         time.Duration
+	circuitDuration        time.Duration
+	circuitData            int64
+}
+
+// listFlag is a flag that may be given more than once, each a comma
+// separated list of values.
+type listFlag struct {
+	values *[]string
+	set    bool
+}
+
+func (f *listFlag) String() string {
+	if f.values == nil {
+		return ""
+	}
+	return strings.Join(*f.values, ",")
+}
+
+func (f *listFlag) Set(s string) error {
+	if !f.set {
+		// Values given on the command line replace the defaults.
+		*f.values = nil
+		f.set = true
+	}
+	for _, v := range strings.Split(s, ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			*f.values = append(*f.values, v)
+		}
+	}
+	return nil
+}
+
+func parseFlags(args []string) (*config, error) {
+	defaults := relayv2.DefaultResources()
+	cfg := &config{
+		listenAddrs: append([]string(nil), defaultListenAddrs...),
+	}
+
+	fs := flag.NewFlagSet("relayd", flag.ExitOnError)
+	fs.Var(&listFlag{values: &cfg.listenAddrs}, "listen", "multiaddrs to listen on, comma separated or repeated")
+	fs.StringVar(&cfg.identityPath, "identity", "identity", "path of the peer identity, generated if it doesn't exist")
+	fs.StringVar(&cfg.dhtMode, "dht-mode", "auto", "DHT mode, one of auto, server or client")
+	fs.Var(&listFlag{values: &cfg.allow}, "allow", "peer IDs allowed to reserve a relay slot, comma separated or repeated; any peer if empty")
+	fs.StringVar(&cfg.metricsAddr, "metrics", "", "address to serve Prometheus metrics on at /metrics, such as :9090")
+	fs.IntVar(&cfg.maxReservations, "max-reservations", defaults.MaxReservations, "maximum number of active relay slots")
+	fs.IntVar(&cfg.maxReservationsPerPeer, "max-reservations-per-peer", defaults.MaxReservationsPerPeer, "maximum number of relay slots reserved by a peer")
+	fs.IntVar(&cfg.maxReservationsPerIP, "max-reservations-per-ip", defaults.MaxReservationsPerIP, "maximum number of relay slots reserved from an IP address")
+	fs.IntVar(&cfg.maxCircuits, "max-circuits", defaults.MaxCircuits, "maximum number of relayed connections open to or from a peer")
+	fs.DurationVar(&cfg.reservationTTL, "reservation-ttl", defaults.ReservationTTL, "how long a relay slot is reserved before it must be refreshed")
+	fs.DurationVar(&cfg.circuitDuration, "circuit-duration", defaults.Limit.Duration, "how long a relayed connection lasts before it is reset; 0 along with -circuit-data 0 for no limit")
+	fs.Int64Var(&cfg.circuitData, "circuit-data", defaults.Limit.Data, "bytes relayed in each direction of a connection before it is reset; 0 along with -circuit-duration 0 for no limit")
+	if err := fs.Parse(args); err != nil {
+		return nil, err
+	}
+	if fs.NArg() > 0 {
+		return nil, fmt.Errorf("unexpected arguments: %s", strings.Join(fs.Args(), " "))
+	}
+	if len(cfg.listenAddrs) == 0 {
+		return nil, errors.New("at least one -listen address is required")
+	}
+	if _, err := cfg.dhtOption(); err != nil {
+		return nil, err
+	}
+	if (cfg.circuitDuration == 0) != (cfg.circuitData == 0) {
+		// The relay resets connections immediately if either limit is
+		// zero, so only both may be.
+		return nil, errors.New("-circuit-duration and -circuit-data must both be 0 to relay connections without limits")
+	}
+
+	cfg.allowPeers = make(map[peer.ID]struct{})
+	for _, s := range cfg.allow {
+		id, err := peer.Decode(s)
+		if err != nil {
+			return nil, fmt.Errorf("invalid -allow peer %q: %w", s, err)
+		}
+		cfg.allowPeers[id] = struct{}{}
+	}
+	return cfg, nil
+}
+
+// resources returns the relay resource limits given by cfg. The relay has no
+// limit on the rate data is relayed at, only on how much is relayed over each
+// connection.
+func (cfg *config) resources() relayv2.Resources {
+	rc := relayv2.DefaultResources()
+	rc.MaxReservations = cfg.maxReservations
+	rc.MaxReservationsPerPeer = cfg.maxReservationsPerPeer
+	rc.MaxReservationsPerIP = cfg.maxReservationsPerIP
+	rc.MaxCircuits = cfg.maxCircuits
+	rc.ReservationTTL = cfg.reservationTTL
+
+	// Relayed connections have no limits if both are zero.
+	rc.Limit = nil
+	if cfg.circuitDuration > 0 {
+		rc.Limit = &relayv2.RelayLimit{
+			Duration: cfg.circuitDuration,
+			Data:     cfg.circuitData,
+		}
+	}
+	return rc
+}
+
+func (cfg *config) dhtOption() (dht.Option, error) {
+	switch cfg.dhtMode {
+	case "auto":
+		return dht.Mode(dht.ModeAutoServer), nil
+	case "server":
+		return dht.Mode(dht.ModeServer), nil
+	case "client":
+		return dht.Mode(dht.ModeClient), nil
+	default:
+		return nil, fmt.Errorf("invalid -dht-mode %q, must be one of auto, server or client", cfg.dhtMode)
+	}
+}
+
 func main() {
-	err := run(context.Background())
+	cfg, err := parseFlags(os.Args[1:])
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "%s\n", err)
+		os.Exit(2)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	sig := make(chan os.Signal, 1)
+	signal.Notify(sig, os.Interrupt, syscall.SIGTERM)
+	go func() {
+		<-sig
+		cancel()
+	}()
+
+	err = run(ctx, cfg)
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "%s\n", err)
 		os.Exit(1)
 	}
 }
 
-func run(ctx context.Context) error {
-	privk, err := loadIdentity("identity")
+func run(ctx context.Context, cfg *config) error {
+	privk, err := loadIdentity(cfg.identityPath)
 	if err != nil {
 		return err
 	}
 
+	dhtMode, err := cfg.dhtOption()
+	if err != nil {
+		return err
+	}
+
+	m := newMetrics()
+	acl := &allowlist{allow: cfg.allowPeers, metrics: m}
+
 	var idht *dht.IpfsDHT
 	host, err := libp2p.New(
 		libp2p.Identity(privk),
-		libp2p.ListenAddrStrings("/ip4/0.0.0.0/tcp/4001"),
+		libp2p.ListenAddrStrings(cfg.listenAddrs...),
 		libp2p.DisableRelay(),
 		libp2p.ForceReachabilityPublic(),
-		libp2p.EnableRelayService(),
+		libp2p.EnableRelayService(
+			relayv2.WithResources(cfg.resources()),
+			relayv2.WithACL(acl),
+		),
 		// This service is highly rate-limited and should not cause any
 		// performance issues.
 		libp2p.EnableNATService(),
 		// Let this host use the DHT to find other hosts
 		libp2p.Routing(func(h host.Host) (routing.PeerRouting, error) {
 			var err error
-			idht, err = dht.New(ctx, h, dht.Mode(dht.ModeAutoServer))
+			idht, err = dht.New(ctx, h, dhtMode)
 			return idht, err
 		}),
 	)
 	if err != nil {
 		return err
 	}
+	defer host.Close()
+	m.host = host
 
 	host.Network().Notify(&network.NotifyBundle{
 		ConnectedF: func(_ network.Network, conn network.Conn) {
+			m.connected()
 			fmt.Printf("Connected to %s [%s]\n", conn.RemotePeer(), conn.RemoteMultiaddr())
 		},
 		DisconnectedF: func(_ network.Network, conn network.Conn) {
@@ -72,11 +255,35 @@ func run(ctx context.Context) error {
 		fmt.Printf("Libp2p swarm listening on %s\n", p2pAddr)
 	}
 
+	if len(cfg.allowPeers) > 0 {
+		fmt.Printf("Allowing %d peers to reserve relay slots\n", len(cfg.allowPeers))
+	}
+
+	errCh := make(chan error, 1)
+	if cfg.metricsAddr != "" {
+		mux := http.NewServeMux()
+		mux.Handle("/metrics", m)
+		srv := &http.Server{Addr: cfg.metricsAddr, Handler: mux}
+		defer srv.Close()
+
+		go func() {
+			err := srv.ListenAndServe()
+			if err != http.ErrServerClosed {
+				errCh <- fmt.Errorf("failed to serve metrics: %w", err)
+			}
+		}()
+		fmt.Printf("Serving metrics on http://%s/metrics\n", cfg.metricsAddr)
+	}
+
 	rd := gdiscovery.NewRoutingDiscovery(idht)
 	go Advertise(ctx, rd)
 
-	select {}
-	return nil
+	select {
+	case <-ctx.Done():
+		return nil
+	case err := <-errCh:
+		return err
+	}
 }
 
 // Advertise advertises this node as a libp2p relay.
@@ -0,0 +1,131 @@
+package main
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"sync/atomic"
+
+	"github.com/libp2p/go-libp2p-core/host"
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/libp2p/go-libp2p/p2p/protocol/circuitv2/proto"
+	ma "github.com/multiformats/go-multiaddr"
+)
+
+// metrics counts the connections and circuits of the relay, and serves them
+// in the Prometheus text format.
+type metrics struct {
+	// host is set once the host is constructed, and the gauges read from
+	// its network when scraped.
+	host host.Host
+
+	connections         uint64
+	reservationsAllowed uint64
+	reservationsDenied  uint64
+	circuitRequests     uint64
+}
+
+func newMetrics() *metrics {
+	return &metrics{}
+}
+
+func (m *metrics) connected() {
+	atomic.AddUint64(&m.connections, 1)
+}
+
+func (m *metrics) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var (
+		peers    int
+		circuits int
+		conns    = make(map[string]int)
+	)
+	if m.host != nil {
+		net := m.host.Network()
+		peers = len(net.Peers())
+		for _, conn := range net.Conns() {
+			conns[transport(conn.RemoteMultiaddr())]++
+			for _, s := range conn.GetStreams() {
+				// Each relayed connection holds a stream open to the
+				// peer it is relayed to.
+				if s.Protocol() == proto.ProtoIDv2Stop {
+					circuits++
+				}
+			}
+		}
+	}
+
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4")
+
+	fmt.Fprintln(w, "# HELP relayd_connections Open connections by transport.")
+	fmt.Fprintln(w, "# TYPE relayd_connections gauge")
+	var transports []string
+	for t := range conns {
+		transports = append(transports, t)
+	}
+	sort.Strings(transports)
+	for _, t := range transports {
+		fmt.Fprintf(w, "relayd_connections{transport=%q} %d\n", t, conns[t])
+	}
+
+	fmt.Fprintln(w, "# HELP relayd_connections_total Connections opened since start.")
+	fmt.Fprintln(w, "# TYPE relayd_connections_total counter")
+	fmt.Fprintf(w, "relayd_connections_total %d\n", atomic.LoadUint64(&m.connections))
+
+	fmt.Fprintln(w, "# HELP relayd_peers Connected peers.")
+	fmt.Fprintln(w, "# TYPE relayd_peers gauge")
+	fmt.Fprintf(w, "relayd_peers %d\n", peers)
+
+	fmt.Fprintln(w, "# HELP relayd_circuits Open relayed connections.")
+	fmt.Fprintln(w, "# TYPE relayd_circuits gauge")
+	fmt.Fprintf(w, "relayd_circuits %d\n", circuits)
+
+	fmt.Fprintln(w, "# HELP relayd_circuit_requests_total Requests to relay a connection since start.")
+	fmt.Fprintln(w, "# TYPE relayd_circuit_requests_total counter")
+	fmt.Fprintf(w, "relayd_circuit_requests_total %d\n", atomic.LoadUint64(&m.circuitRequests))
+
+	fmt.Fprintln(w, "# HELP relayd_reservation_requests_total Requests to reserve a relay slot since start, by whether the allowlist allowed them.")
+	fmt.Fprintln(w, "# TYPE relayd_reservation_requests_total counter")
+	fmt.Fprintf(w, "relayd_reservation_requests_total{result=\"allowed\"} %d\n", atomic.LoadUint64(&m.reservationsAllowed))
+	fmt.Fprintf(w, "relayd_reservation_requests_total{result=\"denied\"} %d\n", atomic.LoadUint64(&m.reservationsDenied))
+}
+
+// transport returns the name of the transport a connection to addr is over.
+func transport(addr ma.Multiaddr) string {
+	for _, t := range []struct {
+		code int
+		name string
+	}{
+		{ma.P_CIRCUIT, "relayed"},
+		{ma.P_WS, "websocket"},
+		{ma.P_QUIC, "quic"},
+		{ma.P_TCP, "tcp"},
+	} {
+		if _, err := addr.ValueForProtocol(t.code); err == nil {
+			return t.name
+		}
+	}
+	return "other"
+}
+
+// allowlist is a relay ACL that only lets the peers in allow reserve relay
+// slots, or any peer if allow is empty. Connections may be relayed from any
+// peer, but only to the peers that reserved a slot.
+type allowlist struct {
+	allow   map[peer.ID]struct{}
+	metrics *metrics
+}
+
+func (a *allowlist) AllowReserve(p peer.ID, _ ma.Multiaddr) bool {
+	_, ok := a.allow[p]
+	if len(a.allow) > 0 && !ok {
+		atomic.AddUint64(&a.metrics.reservationsDenied, 1)
+		return false
+	}
+	atomic.AddUint64(&a.metrics.reservationsAllowed, 1)
+	return true
+}
+
+func (a *allowlist) AllowConnect(src peer.ID, _ ma.Multiaddr, dest peer.ID) bool {
+	atomic.AddUint64(&a.metrics.circuitRequests, 1)
+	return true
+}
@@ -3,25 +3,32 @@ package command
 import (
 	"context"
 	"os"
+	"time"
 
+	"github.com/hinshun/ptmux/control"
 	"github.com/hinshun/ptmux/pkg/p2p"
 	"github.com/hinshun/ptmux/rvt"
 	"github.com/hinshun/ptmux/ui"
+	"github.com/libp2p/go-libp2p-core/peer"
 	gostream "github.com/libp2p/go-libp2p-gostream"
 	"github.com/rs/zerolog"
 	cli "github.com/urfave/cli/v2"
 	"golang.org/x/sync/errgroup"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/keepalive"
 )
 
 func App() *cli.App {
 	app := cli.NewApp()
 	app.Name = "ptmux"
 	app.Usage = "p2p terminal multiplexer"
+	app.Flags = sessionFlags
 	app.Action = StartSession
-	app.Commands = []*cli.Command{
+	app.Commands = append([]*cli.Command{
+		newCommand,
 		attachCommand,
-	}
+		playCommand,
+	}, controlCommands...)
 	return app
 }
 
@@ -36,6 +43,18 @@ func StartSession(c *cli.Context) error {
 	logger := zerolog.Ctx(ctx).Output(zerolog.ConsoleWriter{Out: logs})
 	ctx = logger.WithContext(ctx)
 
+	st, err := loadSession(c)
+	if err != nil {
+		return err
+	}
+	name := sessionName(c, st)
+
+	controlListener, err := control.Listen(name)
+	if err != nil {
+		return err
+	}
+	defer controlListener.Close()
+
 	ctx, cancel := context.WithCancel(ctx)
 	eg, ctx := errgroup.WithContext(ctx)
 
@@ -46,43 +65,126 @@ func StartSession(c *cli.Context) error {
 		}
 		defer p.Close()
 
-		ui, err := ui.New(p.ID().String())
+		hks, err := sessionHooks(ctx, c, st, name)
+		if err != nil {
+			return err
+		}
+
+		// The audit log is opened before the UI takes over the terminal, so
+		// that a bad --audit-log leaves the terminal as it was.
+		auditLog, err := auditLog(ctx, c)
 		if err != nil {
 			return err
 		}
+		defer auditLog.Close()
+
+		ui, err := ui.New(p.ID().String(), st, hks)
+		if err != nil {
+			return err
+		}
+		ui.SetAuditLog(auditLog)
+
+		if path := c.String("record"); path != "" {
+			err = ui.StartRecording(path, c.Bool("record-panes"))
+			if err != nil {
+				ui.Close()
+				return err
+			}
+		}
 
 		eg.Go(func() error {
 			defer cancel()
 			ui.Loop()
+			saveState(ctx, ui, name)
 			return nil
 		})
 
-		screenSrv := rvt.NewServer(ctx, ui.Screen(), p.ID().String())
-		defer screenSrv.Close()
-
-		opts := []grpc.ServerOption{}
-		grpcSrv := grpc.NewServer(opts...)
+		eg.Go(func() error {
+			saveSession(ctx, ui, name, c.Duration("save-interval"))
+			return nil
+		})
 
-		rvt.RegisterScreenServer(grpcSrv, screenSrv)
+		controlSrv := grpc.NewServer()
+		control.RegisterControlServer(controlSrv, ui.ControlServer(name))
+		eg.Go(func() error {
+			return controlSrv.Serve(controlListener)
+		})
 
 		go func() {
 			<-ctx.Done()
-			screenSrv.Cancel()
-			grpcSrv.GracefulStop()
+			controlSrv.Stop()
 		}()
 
-		_, err = p.Discovery.Advertise(ctx, "apple banana")
-		if err != nil {
-			zerolog.Ctx(ctx).Error().Err(err).Msg("unable to advertise")
-		}
+		return serveScreen(ctx, p, ui.Screen(), keepaliveOptions(c)...)
+	})
+
+	return eg.Wait()
+}
+
+// keepaliveFlags configure how peers whose connection went idle are evicted.
+var keepaliveFlags = []cli.Flag{
+	&cli.DurationFlag{
+		Name:  "keepalive",
+		Usage: "how long a peer's connection may be quiet before it is pinged",
+		Value: 30 * time.Second,
+	},
+	&cli.DurationFlag{
+		Name:  "keepalive-timeout",
+		Usage: "how long to wait for a peer to answer a ping before evicting it",
+		Value: 20 * time.Second,
+	},
+}
+
+// keepaliveOptions returns the gRPC options to ping peers and evict those
+// that stop answering, as configured by keepaliveFlags.
+func keepaliveOptions(c *cli.Context) []grpc.ServerOption {
+	return []grpc.ServerOption{
+		grpc.KeepaliveParams(keepalive.ServerParameters{
+			Time:    c.Duration("keepalive"),
+			Timeout: c.Duration("keepalive-timeout"),
+		}),
+		// Attach clients ping the host too, to notice when it goes away.
+		grpc.KeepaliveEnforcementPolicy(keepalive.EnforcementPolicy{
+			MinTime:             attachKeepalive.Time / 2,
+			PermitWithoutStream: true,
+		}),
+	}
+}
+
+// serveScreen shares screen with the peers that attach to p until ctx is
+// done.
+func serveScreen(ctx context.Context, p *p2p.Peer, screen rvt.Screen, opts ...grpc.ServerOption) error {
+	screenSrv := rvt.NewServer(ctx, screen, p.ID().String())
+	defer screenSrv.Close()
 
-		l, err := gostream.Listen(p, "/ptmux/1.0.0")
+	// Peers are authenticated as their libp2p peer ID.
+	screenSrv.ConnType = func(identity string) string {
+		id, err := peer.Decode(identity)
 		if err != nil {
-			return err
+			return ""
 		}
+		return p.ConnType(id)
+	}
 
-		return grpcSrv.Serve(l)
-	})
+	grpcSrv := grpc.NewServer(opts...)
 
-	return eg.Wait()
+	rvt.RegisterScreenServer(grpcSrv, screenSrv)
+
+	go func() {
+		<-ctx.Done()
+		screenSrv.Cancel()
+		grpcSrv.GracefulStop()
+	}()
+
+	_, err := p.Discovery.Advertise(ctx, "apple banana")
+	if err != nil {
+		zerolog.Ctx(ctx).Error().Err(err).Msg("unable to advertise")
+	}
+
+	l, err := gostream.Listen(p, rvt.ProtocolID)
+	if err != nil {
+		return err
+	}
+
+	return grpcSrv.Serve(l)
 }
@@ -7,27 +7,105 @@ import (
 	"io"
 	"net"
 	"os"
+	"sync"
 	"time"
 
 	tcell "github.com/gdamore/tcell/v2"
 	"github.com/hinshun/ptmux/pkg/p2p"
 	"github.com/hinshun/ptmux/rvt"
 	"github.com/libp2p/go-libp2p-core/network"
+	"github.com/libp2p/go-libp2p-core/peer"
 	gostream "github.com/libp2p/go-libp2p-gostream"
 	"github.com/rs/zerolog"
 	cli "github.com/urfave/cli/v2"
 	"golang.org/x/sync/errgroup"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/encoding/gzip"
+	"google.golang.org/grpc/keepalive"
+	"google.golang.org/grpc/status"
 )
 
 var attachCommand = &cli.Command{
 	Name:      "attach",
 	Usage:     "attach to an existing ptmux session",
 	ArgsUsage: "<session-name>",
-	Action:    Attach,
+	Flags: []cli.Flag{
+		controlSessionFlag,
+		&cli.BoolFlag{
+			Name:    "control",
+			Aliases: []string{"C"},
+			Usage:   "attach to a local session in control mode, a line-oriented protocol on stdin and stdout",
+		},
+		&cli.StringFlag{
+			Name:  "colors",
+			Usage: "colors the host sends, from `DEPTH`: auto, truecolor, 256, 16, 8 or mono",
+			Value: "auto",
+		},
+		&cli.StringFlag{
+			Name:  "predict",
+			Usage: "echo printable keys before the host does, `WHEN`: adaptive, always or never",
+			Value: predictNever,
+		},
+		&cli.StringFlag{
+			Name:  "compress",
+			Usage: "compress the stream from the host with `ALGORITHM`: gzip or none",
+			Value: gzip.Name,
+		},
+	},
+	Action: Attach,
 }
 
+// Delays between attempts to reconnect to the host, which double after each
+// failed attempt.
+const (
+	minReconnectDelay = 500 * time.Millisecond
+	maxReconnectDelay = 30 * time.Second
+)
+
+// attachKeepalive pings the host when the connection has been quiet, so that
+// a host that went away is noticed and reconnected to.
+var attachKeepalive = keepalive.ClientParameters{
+	Time:                30 * time.Second,
+	Timeout:             20 * time.Second,
+	PermitWithoutStream: true,
+}
+
+var (
+	// errDetached is returned when the user detaches from the session.
+	errDetached = errors.New("detached")
+
+	// errIncompatible is returned when the host speaks a version of the
+	// protocol this version no longer supports.
+	errIncompatible = errors.New("is incompatible")
+)
+
 func Attach(c *cli.Context) error {
+	if c.Bool("control") {
+		return ControlMode(c)
+	}
+
+	var callOpts []grpc.CallOption
+	switch compress := c.String("compress"); compress {
+	case gzip.Name:
+		callOpts = append(callOpts, grpc.UseCompressor(compress))
+	case "none":
+	default:
+		return fmt.Errorf("unknown compression %q", compress)
+	}
+
+	predict := c.String("predict")
+	switch predict {
+	case predictAdaptive, predictAlways, predictNever:
+	default:
+		return fmt.Errorf("unknown prediction mode %q", predict)
+	}
+
+	colors, err := colorDepth(c.String("colors"))
+	if err != nil {
+		return err
+	}
+
 	logs, err := os.Create("client.log")
 	if err != nil {
 		return err
@@ -44,43 +122,215 @@ func Attach(c *cli.Context) error {
 	}
 	defer p.Close()
 
-	var conn *grpc.ClientConn
-	for conn == nil {
+	host, err := findHost(ctx, p)
+	if err != nil {
+		return err
+	}
+
+	s, err := tcell.NewScreen()
+	if err != nil {
+		return err
+	}
+
+	err = s.Init()
+	if err != nil {
+		return err
+	}
+	if colors == 0 {
+		colors = s.Colors()
+		if colors < rvt.ColorsMono {
+			colors = rvt.ColorsMono
+		}
+	}
+	s.EnableMouse()
+	s.EnablePaste()
+	s.Clear()
+
+	events := make(chan tcell.Event, 4)
+	go func() {
+		defer close(events)
+		for {
+			event := s.PollEvent()
+			if event == nil {
+				return
+			}
+			events <- event
+		}
+	}()
+
+	defer func() {
+		s.Fini()
+		// Drain remaining events.
+		for range events {
+		}
+	}()
+
+	a := &attachment{
+		p:        p,
+		host:     host,
+		screen:   s,
+		events:   events,
+		colors:   colors,
+		predict:  newPredictor(predict, s),
+		callOpts: callOpts,
+	}
+	return a.run(ctx)
+}
+
+// colorDepth returns the number of colors given by --colors, or zero to use
+// the colors of the terminal.
+func colorDepth(depth string) (int, error) {
+	switch depth {
+	case "auto":
+		return 0, nil
+	case "truecolor":
+		return rvt.ColorsTrueColor, nil
+	case "256":
+		return rvt.Colors256, nil
+	case "16":
+		return rvt.Colors16, nil
+	case "8":
+		return rvt.Colors8, nil
+	case "mono":
+		return rvt.ColorsMono, nil
+	}
+	return 0, fmt.Errorf("unknown color depth %q", depth)
+}
+
+// findHost returns the first host found advertising a session.
+func findHost(ctx context.Context, p *p2p.Peer) (peer.ID, error) {
+	for {
 		peerChan, err := p.Discovery.FindPeers(ctx, "apple banana")
 		if err != nil {
-			return fmt.Errorf("unable to find peers: %w", err)
+			return "", fmt.Errorf("unable to find peers: %w", err)
 		}
 
-		for peer := range peerChan {
-			if peer.ID == p.ID() {
+		for info := range peerChan {
+			if info.ID == p.ID() {
 				continue
 			}
+			zerolog.Ctx(ctx).Info().Msgf("Discovered peer %s", info.ID)
+			return info.ID, nil
+		}
+	}
+}
 
-			zerolog.Ctx(ctx).Info().Msgf("Discovered peer %s", peer.ID)
-			dialerOpt := grpc.WithDialer(func(id string, ttl time.Duration) (net.Conn, error) {
-				ctx = network.WithUseTransient(ctx, "hole-punch")
-				return gostream.Dial(ctx, p, peer.ID, "/ptmux/1.0.0")
-			})
-			conn, err = grpc.DialContext(ctx, peer.ID.String(), dialerOpt, grpc.WithInsecure())
-			if err != nil {
-				zerolog.Ctx(ctx).Error().Err(err).Msg("unable to dial peer")
-				continue
-			}
+// attachment is a client attached to the screen of a host. If the stream to
+// the host drops, the client keeps its screen up and reconnects, resuming its
+// session with the token the host gave it.
+type attachment struct {
+	p      *p2p.Peer
+	host   peer.ID
+	screen tcell.Screen
+	events chan tcell.Event
+
+	// colors is the color depth the host downsamples colors to.
+	colors int
+
+	// predict echoes keys before the host does.
+	predict *predictor
+
+	// callOpts are the options of the Share stream, such as its compression.
+	callOpts []grpc.CallOption
+
+	token     string
+	connected bool
+}
+
+// run shares the screen of the host until the user detaches or the host ends
+// the session, reconnecting whenever the stream drops.
+func (a *attachment) run(ctx context.Context) error {
+	delay := minReconnectDelay
+	for attempt := 1; ; attempt++ {
+		a.connected = false
+		err := a.share(ctx)
+		switch {
+		case err == nil, errors.Is(err, errDetached):
+			return nil
+		case ctx.Err() != nil:
+			return ctx.Err()
+		case errors.Is(err, errIncompatible), status.Code(err) == codes.FailedPrecondition:
+			// Reconnecting won't change the version either side speaks.
+			return err
+		case status.Code(err) == codes.Unimplemented && len(a.callOpts) > 0:
+			// Hosts that predate compression can't decompress the stream.
+			zerolog.Ctx(ctx).Info().Err(err).Msg("host doesn't support compression")
+			a.callOpts = nil
+			attempt--
+			continue
+		}
+		zerolog.Ctx(ctx).Error().Err(err).Msg("lost connection to host")
+
+		if a.connected {
+			attempt, delay = 1, minReconnectDelay
+		}
+		if !a.waitReconnect(ctx, attempt, delay) {
+			return nil
+		}
+
+		delay *= 2
+		if delay > maxReconnectDelay {
+			delay = maxReconnectDelay
 		}
 	}
+}
+
+// share streams the screen of the host until the stream ends. It returns nil
+// if the host ended the session, and errDetached if the user detached.
+func (a *attachment) share(ctx context.Context) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	dialerOpt := grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+		ctx = network.WithUseTransient(ctx, "hole-punch")
+		return gostream.Dial(ctx, a.p, a.host, rvt.ProtocolID)
+	})
+	conn, err := grpc.DialContext(ctx, a.host.String(), dialerOpt, grpc.WithInsecure(), grpc.WithKeepaliveParams(attachKeepalive))
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
 
-	screenClient := rvt.NewScreenClient(conn)
-	shareClient, err := screenClient.Share(ctx)
+	shareClient, err := rvt.NewScreenClient(conn).Share(ctx, a.callOpts...)
 	if err != nil {
 		return err
 	}
+	defer shareClient.CloseSend()
+	defer a.predict.stop()
+
+	id := a.p.ID().String()
+	cols, rows := a.screen.Size()
+	initMsg := rvt.NewInitMessage(a.token)
+	initMsg.Cols, initMsg.Rows = int32(cols), int32(rows)
+	initMsg.Colors = int32(a.colors)
+	err = shareClient.Send(&rvt.ShareMessage{
+		Id: id,
+		Message: &rvt.ShareMessage_Init{
+			Init: initMsg,
+		},
+	})
+	if err != nil {
+		return err
+	}
+	zerolog.Ctx(ctx).Info().Bool("resume", a.token != "").Msg("Sent init message")
+
+	// Frames are acknowledged while input is being sent, and a stream may
+	// only be sent on by one goroutine at a time.
+	var sendMu sync.Mutex
+	send := func(msg *rvt.ShareMessage) error {
+		sendMu.Lock()
+		defer sendMu.Unlock()
+		return shareClient.Send(msg)
+	}
 
-	ctx, cancel := context.WithCancel(ctx)
 	eg, ctx := errgroup.WithContext(ctx)
 
-	recvMsgs := make(chan *rvt.ShareMessage)
+	// hostPings is closed once the host is known to answer pings.
+	hostPings := make(chan struct{})
+
 	eg.Go(func() error {
-		defer close(recvMsgs)
+		// Stop sending input once the host ends the session.
+		defer cancel()
 		for {
 			shareMsg, err := shareClient.Recv()
 			if err != nil {
@@ -89,142 +339,197 @@ func Attach(c *cli.Context) error {
 				}
 				return err
 			}
+			a.connected = true
 
-			select {
-			case <-ctx.Done():
-			case recvMsgs <- shareMsg:
-			}
-		}
-	})
+			switch evt := shareMsg.Message.(type) {
+			case *rvt.ShareMessage_Init:
+				err = evt.Init.CheckVersion()
+				if err != nil {
+					return fmt.Errorf("host %w: %s", errIncompatible, err)
+				}
+				zerolog.Ctx(ctx).Info().Uint32("version", evt.Init.ProtocolVersion()).Strs("features", evt.Init.Features).Msg("Host accepted init message")
+				a.token = evt.Init.Token
+				if evt.Init.HasFeature(rvt.FeaturePing) {
+					close(hostPings)
+				}
 
-	sendMsgs := make(chan *rvt.ShareMessage)
-	eg.Go(func() error {
-		defer shareClient.CloseSend()
-		for msg := range sendMsgs {
-			err := shareClient.Send(msg)
-			if err != nil {
-				zerolog.Ctx(ctx).Error().Err(err).Msg("failed to send share message")
-				continue
+				// Hosts that don't size the peer's view from its init
+				// message only learn it from resize events.
+				if !evt.Init.HasFeature(rvt.FeatureResize) {
+					err = send(&rvt.ShareMessage{
+						Id: id,
+						Message: &rvt.ShareMessage_Event{
+							Event: rvt.EventToProto(tcell.NewEventResize(cols, rows)),
+						},
+					})
+					if err != nil {
+						return err
+					}
+				}
+			case *rvt.ShareMessage_Render:
+				a.predict.show(evt.Render)
+				err = send(&rvt.ShareMessage{
+					Id: id,
+					Message: &rvt.ShareMessage_Ack{
+						Ack: &rvt.AckMessage{Frame: evt.Render.Frame},
+					},
+				})
+				if err != nil {
+					return err
+				}
+			case *rvt.ShareMessage_Ping:
+				if evt.Ping.Reply {
+					rtt := evt.Ping.RTT(time.Now())
+					zerolog.Ctx(ctx).Debug().Dur("rtt", rtt).Msg("Host answered ping")
+					a.predict.sample(rtt)
+					continue
+				}
+				err = send(&rvt.ShareMessage{
+					Id: id,
+					Message: &rvt.ShareMessage_Ping{
+						Ping: evt.Ping.Pong(),
+					},
+				})
+				if err != nil {
+					return err
+				}
+			case *rvt.ShareMessage_Bell:
+				a.screen.Beep()
+			case *rvt.ShareMessage_Clipboard:
+				err = rvt.WriteClipboard(os.Stdout, evt.Clipboard.Data)
+				if err != nil {
+					return err
+				}
+			case *rvt.ShareMessage_Leave:
+				zerolog.Ctx(ctx).Info().Msgf("Peer %s left", evt.Leave.Peer)
+			case *rvt.ShareMessage_Exit:
+				zerolog.Ctx(ctx).Info().Str("title", evt.Exit.Title).Msgf("Pane %%%d %s", evt.Exit.Pane, rvt.ExitStatusString(evt.Exit))
 			}
 		}
-		return nil
 	})
 
 	eg.Go(func() error {
-		sendMsgs <- &rvt.ShareMessage{
-			Id: p.ID().String(),
-			Message: &rvt.ShareMessage_Init{
-				Init: &rvt.InitMessage{},
-			},
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-hostPings:
 		}
-		zerolog.Ctx(ctx).Info().Msg("Sent init message")
-		return nil
-	})
-
-	s, err := tcell.NewScreen()
-	if err != nil {
-		return err
-	}
-
-	err = s.Init()
-	if err != nil {
-		return err
-	}
-	s.EnableMouse()
-	s.EnablePaste()
-	s.Clear()
 
-	eg.Go(func() error {
+		ticker := time.NewTicker(rvt.PingInterval)
+		defer ticker.Stop()
+		var seq uint64
 		for {
-			var shareMsg *rvt.ShareMessage
+			var now time.Time
 			select {
 			case <-ctx.Done():
 				return nil
-			case shareMsg = <-recvMsgs:
-			}
-			if shareMsg == nil {
-				return nil
+			case now = <-ticker.C:
 			}
 
-			switch evt := shareMsg.Message.(type) {
-			case *rvt.ShareMessage_Render:
-				rvt.RenderToScreen(evt.Render, s)
+			seq++
+			err := send(&rvt.ShareMessage{
+				Id: id,
+				Message: &rvt.ShareMessage_Ping{
+					Ping: rvt.NewPingMessage(seq, now),
+				},
+			})
+			if err != nil {
+				return err
 			}
 		}
 	})
 
-	eventMsgs := make(chan *rvt.EventMessage, 1)
 	eg.Go(func() error {
-		defer close(sendMsgs)
+		prevWasMouseMove := false
 		for {
-			var eventMsg *rvt.EventMessage
+			var ev tcell.Event
 			select {
 			case <-ctx.Done():
 				return nil
-			case eventMsg = <-eventMsgs:
+			case ev = <-a.events:
 			}
-			if eventMsg == nil {
-				return nil
+			if ev == nil {
+				return errDetached
+			}
+
+			switch evt := ev.(type) {
+			case *tcell.EventKey:
+				if evt.Key() == tcell.KeyCtrlQ {
+					return errDetached
+				}
+				a.predict.key(evt)
+			case *tcell.EventMouse:
+				if evt.Modifiers() == 0 && evt.Buttons() == 0 {
+					if prevWasMouseMove {
+						continue
+					}
+					prevWasMouseMove = true
+				} else {
+					prevWasMouseMove = false
+				}
 			}
-			sendMsgs <- &rvt.ShareMessage{
-				Id: p.ID().String(),
+
+			msg := rvt.EventToProto(ev)
+			if msg == nil {
+				continue
+			}
+			err := send(&rvt.ShareMessage{
+				Id: id,
 				Message: &rvt.ShareMessage_Event{
-					Event: eventMsg,
+					Event: msg,
 				},
+			})
+			if err != nil {
+				return err
 			}
 		}
 	})
 
-	eg.Go(func() error {
-		eventCh := make(chan tcell.Event, 4)
-		go func() {
-			defer close(eventCh)
-			for {
-				event := s.PollEvent()
-				if event == nil {
-					return
-				}
-				eventCh <- event
-			}
-		}()
+	return eg.Wait()
+}
 
-		defer func() {
-			cancel()
-			s.Fini()
-			// Drain remaining events.
-			for _ = range eventCh {
-			}
-		}()
+// waitReconnect shows a banner over the last screen received while waiting
+// for delay before the next attempt to reconnect. It returns false if the
+// user detached instead.
+func (a *attachment) waitReconnect(ctx context.Context, attempt int, delay time.Duration) bool {
+	a.drawBanner(fmt.Sprintf(" reconnecting… attempt %d in %s, Ctrl+q to quit ", attempt, delay))
 
-		prevWasMouseMove := false
-		for {
-			select {
-			case <-ctx.Done():
-				return nil
-			case ev := <-eventCh:
-				switch evt := ev.(type) {
-				case *tcell.EventKey:
-					if evt.Key() == tcell.KeyCtrlQ {
-						return nil
-					}
-				case *tcell.EventMouse:
-					if evt.Modifiers() == 0 && evt.Buttons() == 0 {
-						if prevWasMouseMove {
-							continue
-						}
-						prevWasMouseMove = true
-					} else {
-						prevWasMouseMove = false
-					}
-				}
-				msg := rvt.EventToProto(ev)
-				if msg != nil {
-					eventMsgs <- msg
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return false
+		case <-timer.C:
+			return true
+		case ev := <-a.events:
+			switch evt := ev.(type) {
+			case nil:
+				return false
+			case *tcell.EventKey:
+				if evt.Key() == tcell.KeyCtrlQ {
+					return false
 				}
+			case *tcell.EventResize:
+				a.screen.Sync()
 			}
-
 		}
-	})
+	}
+}
 
-	return eg.Wait()
+func (a *attachment) drawBanner(text string) {
+	cols, _ := a.screen.Size()
+	style := tcell.StyleDefault.Reverse(true)
+	x := 0
+	for _, r := range text {
+		if x >= cols {
+			break
+		}
+		a.screen.SetContent(x, 0, r, nil, style)
+		x++
+	}
+	for ; x < cols; x++ {
+		a.screen.SetContent(x, 0, ' ', nil, style)
+	}
+	a.screen.Show()
 }
@@ -0,0 +1,588 @@
+package command
+
+import (
+	"fmt"
+	"io"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/hinshun/ptmux/control"
+	cli "github.com/urfave/cli/v2"
+)
+
+var (
+	controlSessionFlag = &cli.StringFlag{
+		Name:    "session",
+		Aliases: []string{"s"},
+		Usage:   "name of the session to control",
+		Value:   "default",
+	}
+
+	targetFlag = &cli.StringFlag{
+		Name:    "target",
+		Aliases: []string{"t"},
+		Usage:   "target pane: %ID, INDEX or WINDOW.INDEX, defaulting to the focused pane",
+	}
+
+	// paneSessionFlag is the session flag of commands that take -s as the
+	// source pane, as tmux does.
+	paneSessionFlag = &cli.StringFlag{
+		Name:  "session",
+		Usage: "name of the session to control",
+		Value: "default",
+	}
+
+	sourceFlag = &cli.StringFlag{
+		Name:    "source",
+		Aliases: []string{"s"},
+		Usage:   "source pane: %ID, INDEX or WINDOW.INDEX, defaulting to the focused pane",
+	}
+)
+
+// controlCommands are the commands that control a running session. They are
+// also accepted on stdin in control mode.
+var controlCommands = []*cli.Command{
+	listSessionsCommand,
+	listClientsCommand,
+	listWindowsCommand,
+	listPanesCommand,
+	sendKeysCommand,
+	capturePaneCommand,
+	splitPaneCommand,
+	killPaneCommand,
+	resizePaneCommand,
+	selectLayoutCommand,
+	swapPaneCommand,
+	joinPaneCommand,
+	setOptionCommand,
+	pipePaneCommand,
+	recordCommand,
+}
+
+var listSessionsCommand = &cli.Command{
+	Name:   "list-sessions",
+	Usage:  "list the session and the peers attached to it",
+	Flags:  []cli.Flag{controlSessionFlag},
+	Action: ListSessions,
+}
+
+var listClientsCommand = &cli.Command{
+	Name:   "list-clients",
+	Usage:  "list the peers attached to a session and how many renders each has missed",
+	Flags:  []cli.Flag{controlSessionFlag},
+	Action: ListClients,
+}
+
+var listWindowsCommand = &cli.Command{
+	Name:   "list-windows",
+	Usage:  "list the windows of a session",
+	Flags:  []cli.Flag{controlSessionFlag},
+	Action: ListWindows,
+}
+
+var listPanesCommand = &cli.Command{
+	Name:  "list-panes",
+	Usage: "list the panes of a session",
+	Flags: []cli.Flag{
+		controlSessionFlag,
+		&cli.BoolFlag{
+			Name:    "all",
+			Aliases: []string{"a"},
+			Usage:   "list the panes of every window",
+		},
+		&cli.IntFlag{
+			Name:    "window",
+			Aliases: []string{"w"},
+			Usage:   "index of the window to list, defaulting to the current window",
+			Value:   -1,
+		},
+	},
+	Action: ListPanes,
+}
+
+var sendKeysCommand = &cli.Command{
+	Name:      "send-keys",
+	Usage:     "type keys into a pane",
+	ArgsUsage: "<key>...",
+	Flags: []cli.Flag{
+		controlSessionFlag,
+		targetFlag,
+		&cli.BoolFlag{
+			Name:    "literal",
+			Aliases: []string{"l"},
+			Usage:   "type keys as text without looking up key names such as Enter or C-c",
+		},
+	},
+	Action: SendKeys,
+}
+
+var capturePaneCommand = &cli.Command{
+	Name:  "capture-pane",
+	Usage: "print the contents of a pane",
+	Flags: []cli.Flag{
+		controlSessionFlag,
+		targetFlag,
+		&cli.BoolFlag{
+			Name:    "escapes",
+			Aliases: []string{"e"},
+			Usage:   "include colors and text attributes as escape sequences",
+		},
+		&cli.BoolFlag{
+			Name:    "history",
+			Aliases: []string{"S"},
+			Usage:   "include the lines that scrolled off the top of the pane",
+		},
+	},
+	Action: CapturePane,
+}
+
+var splitPaneCommand = &cli.Command{
+	Name:      "split-pane",
+	Usage:     "split a pane and print the ID of the new pane",
+	ArgsUsage: "[command]...",
+	Flags: []cli.Flag{
+		controlSessionFlag,
+		targetFlag,
+		&cli.BoolFlag{
+			Name:    "vertical",
+			Aliases: []string{"v"},
+			Usage:   "place the new pane beside the target rather than below it",
+		},
+		&cli.StringFlag{
+			Name:    "cwd",
+			Aliases: []string{"c"},
+			Usage:   "working directory of the new pane",
+		},
+	},
+	Action: SplitPane,
+}
+
+var killPaneCommand = &cli.Command{
+	Name:   "kill-pane",
+	Usage:  "kill a pane and the process running in it",
+	Flags:  []cli.Flag{controlSessionFlag, targetFlag},
+	Action: KillPane,
+}
+
+var resizePaneCommand = &cli.Command{
+	Name:      "resize-pane",
+	Usage:     "set the size of a pane relative to the other panes in its split",
+	ArgsUsage: "<weight>",
+	Flags:     []cli.Flag{controlSessionFlag, targetFlag},
+	Action:    ResizePane,
+}
+
+var selectLayoutCommand = &cli.Command{
+	Name:      "select-layout",
+	Usage:     "arrange the panes of a window by a named layout or a layout string from list-windows",
+	ArgsUsage: "<layout>",
+	Flags:     []cli.Flag{controlSessionFlag, targetFlag},
+	Action:    SelectLayout,
+}
+
+var swapPaneCommand = &cli.Command{
+	Name:   "swap-pane",
+	Usage:  "exchange the positions of two panes, which may be in different windows",
+	Flags:  []cli.Flag{paneSessionFlag, sourceFlag, targetFlag},
+	Action: SwapPane,
+}
+
+var joinPaneCommand = &cli.Command{
+	Name:  "join-pane",
+	Usage: "move the source pane into the window of the target pane by splitting it",
+	Flags: []cli.Flag{
+		paneSessionFlag,
+		sourceFlag,
+		targetFlag,
+		&cli.BoolFlag{
+			Name:    "vertical",
+			Aliases: []string{"v"},
+			Usage:   "place the source pane beside the target rather than below it",
+		},
+	},
+	Action: JoinPane,
+}
+
+var setOptionCommand = &cli.Command{
+	Name:      "set-option",
+	Usage:     "set an option of a pane: monitor-activity, monitor-bell or monitor-silence",
+	ArgsUsage: "<name> <value>",
+	Flags:     []cli.Flag{controlSessionFlag, targetFlag},
+	Action:    SetOption,
+}
+
+var recordCommand = &cli.Command{
+	Name:      "record",
+	Usage:     "record a session as asciicast, or stop recording",
+	ArgsUsage: "[path]",
+	Flags: []cli.Flag{
+		controlSessionFlag,
+		&cli.BoolFlag{
+			Name:  "panes",
+			Usage: "record each pane to a file of its own instead of the screen",
+		},
+		&cli.BoolFlag{
+			Name:  "stop",
+			Usage: "stop the recording in progress",
+		},
+	},
+	Action: Record,
+}
+
+var pipePaneCommand = &cli.Command{
+	Name:      "pipe-pane",
+	Usage:     "pipe the output of a pane to a shell command, or stop piping if no command is given",
+	ArgsUsage: "[command]",
+	Flags: []cli.Flag{
+		controlSessionFlag,
+		targetFlag,
+		&cli.StringFlag{
+			Name:    "file",
+			Aliases: []string{"f"},
+			Usage:   "append the output to `PATH` instead of piping it to a command",
+		},
+		&cli.BoolFlag{
+			Name:  "strip",
+			Usage: "remove escape sequences, leaving only the text of the output",
+		},
+		&cli.BoolFlag{
+			Name:    "toggle",
+			Aliases: []string{"o"},
+			Usage:   "stop piping instead if the pane is already being piped",
+		},
+	},
+	Action: PipePane,
+}
+
+func controlClient(c *cli.Context) (control.ControlClient, func() error, error) {
+	conn, err := control.Dial(c.Context, c.String("session"))
+	if err != nil {
+		return nil, nil, err
+	}
+	return control.NewControlClient(conn), conn.Close, nil
+}
+
+func ListSessions(c *cli.Context) error {
+	client, closer, err := controlClient(c)
+	if err != nil {
+		return err
+	}
+	defer closer()
+
+	resp, err := client.ListSessions(c.Context, &control.ListSessionsRequest{})
+	if err != nil {
+		return err
+	}
+
+	for _, s := range resp.Sessions {
+		line := fmt.Sprintf("%s: %d windows", s.Name, s.Windows)
+		if len(s.Peers) > 0 {
+			line += fmt.Sprintf(" (peers: %s)", strings.Join(s.Peers, ", "))
+		}
+		fmt.Fprintln(c.App.Writer, line)
+	}
+	return nil
+}
+
+func ListClients(c *cli.Context) error {
+	client, closer, err := controlClient(c)
+	if err != nil {
+		return err
+	}
+	defer closer()
+
+	resp, err := client.ListClients(c.Context, &control.ListClientsRequest{})
+	if err != nil {
+		return err
+	}
+
+	for _, cl := range resp.Clients {
+		if cl.Detached {
+			fmt.Fprintf(c.App.Writer, "%s: detached\n", cl.Peer)
+			continue
+		}
+		fmt.Fprintf(c.App.Writer, "%s: %d renders, %d sent, %d coalesced, %d dropped\n", cl.Peer, cl.Renders, cl.Delivered, cl.Coalesced, cl.Dropped)
+	}
+	return nil
+}
+
+func ListWindows(c *cli.Context) error {
+	client, closer, err := controlClient(c)
+	if err != nil {
+		return err
+	}
+	defer closer()
+
+	resp, err := client.ListWindows(c.Context, &control.ListWindowsRequest{})
+	if err != nil {
+		return err
+	}
+
+	for _, w := range resp.Windows {
+		line := fmt.Sprintf("%d: @%d [%s] (%d panes)", w.Index, w.Id, w.Layout, w.Panes)
+		if w.Active {
+			line += " (active)"
+		}
+		fmt.Fprintln(c.App.Writer, line)
+	}
+	return nil
+}
+
+func ListPanes(c *cli.Context) error {
+	client, closer, err := controlClient(c)
+	if err != nil {
+		return err
+	}
+	defer closer()
+
+	resp, err := client.ListPanes(c.Context, &control.ListPanesRequest{
+		Window: int32(c.Int("window")),
+		All:    c.Bool("all"),
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, p := range resp.Panes {
+		line := fmt.Sprintf("%d.%d: [%dx%d] %%%d %q", p.Window, p.Index, p.Width, p.Height, p.Id, p.Title)
+		if p.Active {
+			line += " (active)"
+		}
+		if p.Dead {
+			line += " (dead)"
+		}
+		if p.Status != "" {
+			line += fmt.Sprintf(" [%s]", p.Status)
+		}
+		if p.Alerts != "" {
+			line += fmt.Sprintf(" [%s]", p.Alerts)
+		}
+		if p.Pipe != "" {
+			line += fmt.Sprintf(" | %s", p.Pipe)
+		}
+		fmt.Fprintln(c.App.Writer, line)
+	}
+	return nil
+}
+
+func SendKeys(c *cli.Context) error {
+	if c.NArg() == 0 {
+		return fmt.Errorf("send-keys requires at least one key")
+	}
+
+	client, closer, err := controlClient(c)
+	if err != nil {
+		return err
+	}
+	defer closer()
+
+	_, err = client.SendKeys(c.Context, &control.SendKeysRequest{
+		Target:  c.String("target"),
+		Keys:    c.Args().Slice(),
+		Literal: c.Bool("literal"),
+	})
+	return err
+}
+
+func CapturePane(c *cli.Context) error {
+	client, closer, err := controlClient(c)
+	if err != nil {
+		return err
+	}
+	defer closer()
+
+	resp, err := client.CapturePane(c.Context, &control.CapturePaneRequest{
+		Target:  c.String("target"),
+		Escapes: c.Bool("escapes"),
+		History: c.Bool("history"),
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = io.WriteString(c.App.Writer, resp.Content)
+	return err
+}
+
+func SplitPane(c *cli.Context) error {
+	client, closer, err := controlClient(c)
+	if err != nil {
+		return err
+	}
+	defer closer()
+
+	resp, err := client.SplitPane(c.Context, &control.SplitPaneRequest{
+		Target:   c.String("target"),
+		Vertical: c.Bool("vertical"),
+		Command:  c.Args().Slice(),
+		Cwd:      c.String("cwd"),
+	})
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(c.App.Writer, "%%%d\n", resp.Pane.Id)
+	return nil
+}
+
+func KillPane(c *cli.Context) error {
+	client, closer, err := controlClient(c)
+	if err != nil {
+		return err
+	}
+	defer closer()
+
+	_, err = client.KillPane(c.Context, &control.KillPaneRequest{
+		Target: c.String("target"),
+	})
+	return err
+}
+
+func ResizePane(c *cli.Context) error {
+	if c.NArg() != 1 {
+		return fmt.Errorf("resize-pane requires a weight")
+	}
+	weight, err := strconv.Atoi(c.Args().First())
+	if err != nil || weight < 1 {
+		return fmt.Errorf("weight must be a positive integer")
+	}
+
+	client, closer, err := controlClient(c)
+	if err != nil {
+		return err
+	}
+	defer closer()
+
+	_, err = client.ResizePane(c.Context, &control.ResizePaneRequest{
+		Target: c.String("target"),
+		Weight: int32(weight),
+	})
+	return err
+}
+
+func SelectLayout(c *cli.Context) error {
+	if c.NArg() != 1 {
+		return fmt.Errorf("select-layout requires a layout")
+	}
+
+	client, closer, err := controlClient(c)
+	if err != nil {
+		return err
+	}
+	defer closer()
+
+	_, err = client.SelectLayout(c.Context, &control.SelectLayoutRequest{
+		Target: c.String("target"),
+		Layout: c.Args().First(),
+	})
+	return err
+}
+
+func SwapPane(c *cli.Context) error {
+	client, closer, err := controlClient(c)
+	if err != nil {
+		return err
+	}
+	defer closer()
+
+	_, err = client.SwapPane(c.Context, &control.SwapPaneRequest{
+		Source: c.String("source"),
+		Target: c.String("target"),
+	})
+	return err
+}
+
+func JoinPane(c *cli.Context) error {
+	client, closer, err := controlClient(c)
+	if err != nil {
+		return err
+	}
+	defer closer()
+
+	_, err = client.JoinPane(c.Context, &control.JoinPaneRequest{
+		Source:   c.String("source"),
+		Target:   c.String("target"),
+		Vertical: c.Bool("vertical"),
+	})
+	return err
+}
+
+func SetOption(c *cli.Context) error {
+	if c.NArg() != 2 {
+		return fmt.Errorf("set-option requires a name and a value")
+	}
+
+	client, closer, err := controlClient(c)
+	if err != nil {
+		return err
+	}
+	defer closer()
+
+	_, err = client.SetOption(c.Context, &control.SetOptionRequest{
+		Target: c.String("target"),
+		Name:   c.Args().Get(0),
+		Value:  c.Args().Get(1),
+	})
+	return err
+}
+
+func Record(c *cli.Context) error {
+	// Paths are relative to the client, not the session.
+	path := c.Args().First()
+	if path != "" {
+		var err error
+		path, err = filepath.Abs(path)
+		if err != nil {
+			return err
+		}
+	}
+
+	client, closer, err := controlClient(c)
+	if err != nil {
+		return err
+	}
+	defer closer()
+
+	resp, err := client.Record(c.Context, &control.RecordRequest{
+		Path:  path,
+		Panes: c.Bool("panes"),
+		Stop:  c.Bool("stop"),
+	})
+	if err != nil {
+		return err
+	}
+	fmt.Fprintln(c.App.Writer, resp.Path)
+	return nil
+}
+
+func PipePane(c *cli.Context) error {
+	command := strings.Join(c.Args().Slice(), " ")
+	path := c.String("file")
+	if command != "" && path != "" {
+		return fmt.Errorf("pipe-pane takes either a command or --file")
+	}
+	if path != "" {
+		// Paths are relative to the client, not the session.
+		var err error
+		path, err = filepath.Abs(path)
+		if err != nil {
+			return err
+		}
+	}
+
+	client, closer, err := controlClient(c)
+	if err != nil {
+		return err
+	}
+	defer closer()
+
+	_, err = client.PipePane(c.Context, &control.PipePaneRequest{
+		Target:  c.String("target"),
+		Command: command,
+		Path:    path,
+		Strip:   c.Bool("strip"),
+		Toggle:  c.Bool("toggle"),
+	})
+	return err
+}
@@ -0,0 +1,238 @@
+package command
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/hinshun/ptmux/control"
+	cli "github.com/urfave/cli/v2"
+)
+
+// ControlMode speaks a line-oriented protocol on stdin and stdout so that
+// editors and IDEs can drive a session without parsing a rendered screen.
+//
+// Every line written to stdout starts with a notification:
+//
+//	%output %<pane> <data>
+//	%layout-change @<window> <layout> %<pane>,...
+//	%window-add @<window>
+//	%window-close @<window>
+//	%peer-join <peer>
+//	%peer-leave <peer>
+//	%resync <dropped>
+//	%exit
+//
+// Pane output escapes backslashes and control characters in octal, so that
+// it always fits on one line. Notifications are dropped while the client
+// falls behind, after which %resync says how many were missed, so that a
+// client mirroring panes from %output knows to capture them again.
+//
+// Each line read from stdin is a control command such as "send-keys -t %1 ls
+// Enter", split into words as a shell would. Its output is written between
+// "%begin <n>" and "%end <n>", or "%error <n>" if it failed, where n counts
+// commands from 1. An empty line or the end of stdin leaves control mode.
+func ControlMode(c *cli.Context) error {
+	ctx, cancel := context.WithCancel(c.Context)
+	defer cancel()
+
+	client, closer, err := controlClient(c)
+	if err != nil {
+		return err
+	}
+	defer closer()
+
+	stream, err := client.Events(ctx, &control.EventsRequest{})
+	if err != nil {
+		return err
+	}
+
+	eventCh := make(chan *control.Event)
+	errCh := make(chan error, 1)
+	go func() {
+		for {
+			ev, err := stream.Recv()
+			if err != nil {
+				errCh <- err
+				return
+			}
+			select {
+			case <-ctx.Done():
+				return
+			case eventCh <- ev:
+			}
+		}
+	}()
+
+	lines := make(chan string)
+	go func() {
+		defer close(lines)
+		scanner := bufio.NewScanner(os.Stdin)
+		for scanner.Scan() {
+			select {
+			case <-ctx.Done():
+				return
+			case lines <- scanner.Text():
+			}
+		}
+	}()
+
+	out := bufio.NewWriter(os.Stdout)
+	defer out.Flush()
+
+	app := controlModeApp()
+	session := c.String("session")
+	for n := 1; ; {
+		select {
+		case ev := <-eventCh:
+			writeEvent(out, ev)
+		case <-errCh:
+			// The host has gone away.
+			io.WriteString(out, "%exit\n")
+			return nil
+		case line, ok := <-lines:
+			if !ok || line == "" {
+				return nil
+			}
+			runControlCommand(ctx, out, app, session, n, line)
+			n++
+		}
+		out.Flush()
+	}
+}
+
+func controlModeApp() *cli.App {
+	app := cli.NewApp()
+	app.Name = "ptmux"
+	app.Commands = controlCommands
+	app.HideVersion = true
+	// Errors are reported to the client rather than exiting.
+	app.ExitErrHandler = func(*cli.Context, error) {}
+	return app
+}
+
+func runControlCommand(ctx context.Context, out io.Writer, app *cli.App, session string, n int, line string) {
+	var buf bytes.Buffer
+	err := func() error {
+		words, err := splitWords(line)
+		if err != nil {
+			return err
+		}
+		if app.Command(words[0]) == nil {
+			return fmt.Errorf("unknown command %q", words[0])
+		}
+
+		args := append([]string{app.Name, words[0], "--session", session}, words[1:]...)
+		app.Writer = &buf
+		app.ErrWriter = &buf
+		return app.RunContext(ctx, args)
+	}()
+
+	fmt.Fprintf(out, "%%begin %d\n", n)
+	if err != nil {
+		fmt.Fprintln(out, err)
+		fmt.Fprintf(out, "%%error %d\n", n)
+		return
+	}
+
+	out.Write(buf.Bytes())
+	if buf.Len() > 0 && !bytes.HasSuffix(buf.Bytes(), []byte("\n")) {
+		fmt.Fprintln(out)
+	}
+	fmt.Fprintf(out, "%%end %d\n", n)
+}
+
+func writeEvent(out io.Writer, ev *control.Event) {
+	switch e := ev.Event.(type) {
+	case *control.Event_Output:
+		fmt.Fprintf(out, "%%output %%%d %s\n", e.Output.Pane, escapeOutput(e.Output.Data))
+	case *control.Event_LayoutChange:
+		panes := make([]string, len(e.LayoutChange.Panes))
+		for i, p := range e.LayoutChange.Panes {
+			panes[i] = fmt.Sprintf("%%%d", p)
+		}
+		fmt.Fprintf(out, "%%layout-change @%d %s %s\n", e.LayoutChange.Window, e.LayoutChange.Layout, strings.Join(panes, ","))
+	case *control.Event_WindowAdd:
+		fmt.Fprintf(out, "%%window-add @%d\n", e.WindowAdd.Window)
+	case *control.Event_WindowClose:
+		fmt.Fprintf(out, "%%window-close @%d\n", e.WindowClose.Window)
+	case *control.Event_PeerJoin:
+		fmt.Fprintf(out, "%%peer-join %s\n", e.PeerJoin.Peer)
+	case *control.Event_PeerLeave:
+		fmt.Fprintf(out, "%%peer-leave %s\n", e.PeerLeave.Peer)
+	case *control.Event_Resync:
+		fmt.Fprintf(out, "%%resync %d\n", e.Resync.Dropped)
+	}
+}
+
+// escapeOutput writes backslashes and control characters as octal escapes.
+func escapeOutput(data []byte) string {
+	var sb strings.Builder
+	for _, b := range data {
+		if b < ' ' || b == '\\' || b == 0x7f {
+			fmt.Fprintf(&sb, "\\%03o", b)
+			continue
+		}
+		sb.WriteByte(b)
+	}
+	return sb.String()
+}
+
+// splitWords splits a command line into words. Words are separated by spaces
+// and may be quoted with single or double quotes, and a backslash escapes the
+// next character outside single quotes.
+func splitWords(line string) ([]string, error) {
+	var (
+		words  []string
+		word   strings.Builder
+		inWord bool
+		quote  rune
+		escape bool
+	)
+	for _, r := range line {
+		switch {
+		case escape:
+			word.WriteRune(r)
+			escape = false
+		case r == '\\' && quote != '\'':
+			escape = true
+			inWord = true
+		case quote != 0:
+			if r == quote {
+				quote = 0
+			} else {
+				word.WriteRune(r)
+			}
+		case r == '\'' || r == '"':
+			quote = r
+			inWord = true
+		case r == ' ' || r == '\t':
+			if inWord {
+				words = append(words, word.String())
+				word.Reset()
+				inWord = false
+			}
+		default:
+			word.WriteRune(r)
+			inWord = true
+		}
+	}
+
+	switch {
+	case escape:
+		return nil, fmt.Errorf("trailing backslash")
+	case quote != 0:
+		return nil, fmt.Errorf("unterminated %c quote", quote)
+	}
+	if inWord {
+		words = append(words, word.String())
+	}
+	if len(words) == 0 {
+		return nil, fmt.Errorf("empty command")
+	}
+	return words, nil
+}
@@ -0,0 +1,168 @@
+package command
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hinshun/ptmux/pkg/audit"
+	"github.com/hinshun/ptmux/pkg/hooks"
+	"github.com/hinshun/ptmux/pkg/state"
+	"github.com/hinshun/ptmux/pkg/workspace"
+	"github.com/hinshun/ptmux/ui"
+	"github.com/rs/zerolog"
+	cli "github.com/urfave/cli/v2"
+)
+
+var sessionFlags = append([]cli.Flag{
+	&cli.StringFlag{
+		Name:    "name",
+		Aliases: []string{"s"},
+		Usage:   "name the session is saved as",
+		Value:   "default",
+	},
+	&cli.StringFlag{
+		Name:    "file",
+		Aliases: []string{"f"},
+		Usage:   "start the windows and panes described by the workspace file `PATH`",
+	},
+	&cli.StringFlag{
+		Name:  "restore",
+		Usage: "restore the windows and panes of the saved session `NAME`",
+	},
+	&cli.DurationFlag{
+		Name:  "save-interval",
+		Usage: "how often the session is saved, or 0 to only save on exit",
+		Value: 30 * time.Second,
+	},
+	&cli.StringFlag{
+		Name:  "record",
+		Usage: "record the session as asciicast to `PATH`",
+	},
+	&cli.BoolFlag{
+		Name:  "record-panes",
+		Usage: "record each pane to a file of its own next to --record instead of the screen",
+	},
+	&cli.StringFlag{
+		Name:  "audit-log",
+		Usage: "log the input of remote peers as JSON lines to `PATH`",
+	},
+	&cli.Int64Flag{
+		Name:  "audit-max-size",
+		Usage: "rotate the audit log once it grows past this many `MB`",
+		Value: audit.DefaultMaxSize >> 20,
+	},
+	&cli.IntFlag{
+		Name:  "audit-max-files",
+		Usage: "number of rotated audit logs to keep",
+		Value: audit.DefaultMaxFiles,
+	},
+	&cli.BoolFlag{
+		Name:  "audit-redact",
+		Usage: "leave out keys typed at password prompts, where the terminal reads a line without echoing it",
+		Value: true,
+	},
+	&cli.StringSliceFlag{
+		Name:  "hook",
+		Usage: "run `EVENT=COMMAND` whenever EVENT happens, e.g. peer-join='notify-send \"$PTMUX_PEER joined\"'",
+	},
+}, keepaliveFlags...)
+
+var newCommand = &cli.Command{
+	Name:   "new",
+	Usage:  "start a new ptmux session",
+	Flags:  sessionFlags,
+	Action: StartSession,
+}
+
+// sessionName returns the name the session is saved as. Restored sessions
+// and workspaces keep their name unless one is given explicitly.
+func sessionName(c *cli.Context, st *state.Session) string {
+	if st != nil && !c.IsSet("name") {
+		return st.Name
+	}
+	return c.String("name")
+}
+
+// loadSession returns the saved session to restore or the session described
+// by a workspace file, or nil if the session should start with a single pane.
+func loadSession(c *cli.Context) (*state.Session, error) {
+	file, name := c.String("file"), c.String("restore")
+	switch {
+	case file != "" && name != "":
+		return nil, fmt.Errorf("--file and --restore cannot be used together")
+	case file != "":
+		return workspace.Load(file)
+	case name != "":
+		return state.Load(name)
+	}
+	return nil, nil
+}
+
+// sessionHooks returns the hooks given by --hook, along with those of the
+// restored session or workspace.
+func sessionHooks(ctx context.Context, c *cli.Context, st *state.Session, name string) (*hooks.Hooks, error) {
+	commands, err := hooks.Parse(c.StringSlice("hook"))
+	if err != nil {
+		return nil, err
+	}
+	if st == nil {
+		return hooks.New(ctx, name, commands)
+	}
+	return hooks.New(ctx, name, st.Hooks, commands)
+}
+
+// auditLog opens the audit log given by --audit-log, or returns nil if there
+// isn't one.
+func auditLog(ctx context.Context, c *cli.Context) (*audit.Log, error) {
+	path := c.String("audit-log")
+	if path == "" {
+		return nil, nil
+	}
+	return audit.Open(ctx, path, audit.Options{
+		MaxSize:  c.Int64("audit-max-size") << 20,
+		MaxFiles: c.Int("audit-max-files"),
+		Redact:   c.Bool("audit-redact"),
+	})
+}
+
+// saveSession saves the session every interval until ctx is done.
+func saveSession(ctx context.Context, ui *ui.UI, name string, interval time.Duration) {
+	if interval <= 0 {
+		return
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			saveState(ctx, ui, name)
+		}
+	}
+}
+
+func saveState(ctx context.Context, ui *ui.UI, name string) {
+	st, err := ui.State(ctx)
+	if err != nil {
+		zerolog.Ctx(ctx).Error().Err(err).Msg("unable to get session state")
+		return
+	}
+	if st == nil {
+		// Every pane exited, so the session ended rather than being left
+		// to restore later.
+		err = state.Remove(name)
+		if err != nil {
+			zerolog.Ctx(ctx).Error().Err(err).Str("session", name).Msg("unable to remove saved session")
+		}
+		return
+	}
+
+	st.Name = name
+	err = state.Save(st)
+	if err != nil {
+		zerolog.Ctx(ctx).Error().Err(err).Str("session", name).Msg("unable to save session")
+	}
+}
@@ -0,0 +1,83 @@
+package command
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/hinshun/ptmux/pkg/asciicast"
+	"github.com/hinshun/ptmux/pkg/p2p"
+	"github.com/hinshun/ptmux/ui"
+	"github.com/rs/zerolog"
+	cli "github.com/urfave/cli/v2"
+	"golang.org/x/sync/errgroup"
+)
+
+var playCommand = &cli.Command{
+	Name:      "play",
+	Usage:     "play back a recorded session, which peers can attach to and watch",
+	ArgsUsage: "<file.cast>",
+	Flags: append([]cli.Flag{
+		&cli.BoolFlag{
+			Name:  "local",
+			Usage: "play back without sharing the playback with peers",
+		},
+	}, keepaliveFlags...),
+	Action: Play,
+}
+
+func Play(c *cli.Context) error {
+	if c.NArg() != 1 {
+		return fmt.Errorf("expected a recording to play")
+	}
+
+	rec, err := asciicast.Open(c.Args().First())
+	if err != nil {
+		return err
+	}
+
+	logs, err := os.Create("server.log")
+	if err != nil {
+		return err
+	}
+	defer logs.Close()
+
+	ctx := c.Context
+	logger := zerolog.Ctx(ctx).Output(zerolog.ConsoleWriter{Out: logs})
+	ctx = logger.WithContext(ctx)
+
+	if c.Bool("local") {
+		ui, err := ui.NewPlayer("host", rec)
+		if err != nil {
+			return err
+		}
+		ui.Loop()
+		return nil
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	eg, ctx := errgroup.WithContext(ctx)
+
+	eg.Go(func() error {
+		p, err := p2p.New(ctx)
+		if err != nil {
+			return err
+		}
+		defer p.Close()
+
+		ui, err := ui.NewPlayer(p.ID().String(), rec)
+		if err != nil {
+			return err
+		}
+
+		eg.Go(func() error {
+			defer cancel()
+			ui.Loop()
+			return nil
+		})
+
+		return serveScreen(ctx, p, ui.Screen(), keepaliveOptions(c)...)
+	})
+
+	return eg.Wait()
+}
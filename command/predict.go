@@ -0,0 +1,257 @@
+package command
+
+import (
+	"sync"
+	"time"
+	"unicode"
+
+	tcell "github.com/gdamore/tcell/v2"
+	"github.com/hinshun/ptmux/rvt"
+	"github.com/mattn/go-runewidth"
+)
+
+// When printable keys are echoed before the host echoes them, as given by
+// --predict.
+const (
+	// predictAdaptive echoes keys early once the round-trip time to the
+	// host is long enough for the lag to show.
+	predictAdaptive = "adaptive"
+	predictAlways   = "always"
+	predictNever    = "never"
+)
+
+const (
+	// predictThreshold is the round-trip time above which keys are echoed
+	// early by predictAdaptive.
+	predictThreshold = 30 * time.Millisecond
+
+	// Bounds on how long a key echoed early may wait for the host to echo
+	// it too, which is twice the round-trip time once it is known.
+	minPredictTimeout = 250 * time.Millisecond
+	maxPredictTimeout = 5 * time.Second
+)
+
+// prediction is a printable key drawn where the host is expected to echo it,
+// over the rune under that was there before.
+type prediction struct {
+	x, y  int
+	r     rune
+	under rune
+	at    time.Time
+}
+
+// predictor echoes printable keys typed into a pane that appears to be
+// editing a line before the host does, as mosh does, so that typing over a
+// slow connection doesn't lag. Keys are drawn underlined after the cursor of
+// the last render until a render confirms them. If a key isn't confirmed in
+// time, every prediction is dropped and keys aren't echoed until the host
+// has caught up.
+//
+// Predictions are only drawn once the host has confirmed one since the last
+// key that couldn't be predicted, such as Enter, so that the first key typed
+// at a prompt that doesn't echo, such as for a password, is never shown.
+type predictor struct {
+	mode   string
+	screen tcell.Screen
+
+	mu     sync.Mutex
+	render *rvt.RenderMessage
+	srtt   time.Duration
+	preds  []prediction
+
+	// x and y are where the next key will be echoed.
+	x, y int
+
+	// hold is when keys may be echoed again after a key that moves the
+	// cursor unpredictably, or a misprediction.
+	hold time.Time
+
+	// confirmed is set once the host has confirmed a prediction since the
+	// last key that couldn't be predicted, and predictions are drawn.
+	confirmed bool
+
+	timer *time.Timer
+}
+
+func newPredictor(mode string, s tcell.Screen) *predictor {
+	return &predictor{mode: mode, screen: s}
+}
+
+// sample updates the smoothed round-trip time to the host with rtt.
+func (p *predictor) sample(rtt time.Duration) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.srtt == 0 {
+		p.srtt = rtt
+	} else {
+		p.srtt = (7*p.srtt + rtt) / 8
+	}
+}
+
+// timeout returns how long a prediction may wait to be confirmed.
+func (p *predictor) timeout() time.Duration {
+	d := 2 * p.srtt
+	if d < minPredictTimeout {
+		d = minPredictTimeout
+	}
+	if d > maxPredictTimeout {
+		d = maxPredictTimeout
+	}
+	return d
+}
+
+// show draws msg from the host and shows it, with the predictions it doesn't
+// confirm yet drawn over it.
+func (p *predictor) show(msg *rvt.RenderMessage) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.render = msg
+	p.redraw(time.Now())
+}
+
+// key echoes ev if it is a printable key typed where it can be predicted.
+func (p *predictor) key(ev *tcell.EventKey) {
+	if p.mode == predictNever {
+		return
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	now := time.Now()
+	r := ev.Rune()
+	if ev.Key() != tcell.KeyRune || ev.Modifiers()&^tcell.ModShift != 0 || !unicode.IsPrint(r) || runewidth.RuneWidth(r) != 1 {
+		// Keys such as Enter, the arrows or the prefix key move the cursor
+		// in ways that can't be predicted, so predictions wait for the host
+		// to catch up.
+		p.hold = now.Add(p.timeout())
+		p.confirmed = false
+		if len(p.preds) > 0 {
+			p.preds = nil
+			p.redraw(now)
+		}
+		return
+	}
+
+	if p.render == nil || now.Before(p.hold) {
+		return
+	}
+	cursor := p.render.Cursor
+	if cursor == nil || !cursor.Predict {
+		return
+	}
+	if p.mode == predictAdaptive && p.srtt < predictThreshold {
+		return
+	}
+
+	if len(p.preds) == 0 {
+		p.x, p.y = int(cursor.X), int(cursor.Y)
+	}
+	// Keys that would wrap onto the next line aren't predicted.
+	cols, _ := p.screen.Size()
+	if p.x >= cols-1 {
+		return
+	}
+
+	under, _, _, _ := p.screen.GetContent(p.x, p.y)
+	p.preds = append(p.preds, prediction{x: p.x, y: p.y, r: r, under: under, at: now})
+	p.x++
+	p.draw()
+	p.screen.Show()
+
+	if p.timer == nil {
+		p.timer = time.AfterFunc(p.timeout(), p.expire)
+	}
+}
+
+// expire drops the predictions that weren't confirmed in time.
+func (p *predictor) expire() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.timer = nil
+	if p.render == nil {
+		return
+	}
+	now := time.Now()
+	p.redraw(now)
+	if len(p.preds) > 0 {
+		p.timer = time.AfterFunc(p.preds[0].at.Add(p.timeout()).Sub(now), p.expire)
+	}
+}
+
+// stop drops the predictions once the stream to the host has ended, so that
+// nothing more is drawn until the next render.
+func (p *predictor) stop() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.render = nil
+	p.preds = nil
+	if p.timer != nil {
+		p.timer.Stop()
+		p.timer = nil
+	}
+	p.screen.HideCursor()
+}
+
+// redraw draws the last render, drops the predictions it confirms or
+// contradicts, and draws the rest over it. It must be called with mu held.
+func (p *predictor) redraw(now time.Time) {
+	rvt.DrawRender(p.render, p.screen)
+	p.reconcile(now)
+	p.draw()
+	p.screen.Show()
+}
+
+// reconcile drops the predictions confirmed by the render just drawn. If any
+// has waited too long to be confirmed, or the pane no longer appears to be
+// editing a line, every prediction is dropped.
+func (p *predictor) reconcile(now time.Time) {
+	cursor := p.render.Cursor
+	if cursor == nil || !cursor.Predict {
+		p.preds = nil
+		return
+	}
+
+	var pending []prediction
+	for _, pred := range p.preds {
+		mainc, _, _, _ := p.screen.GetContent(pred.x, pred.y)
+		if mainc == pred.r {
+			// Keys typed over the same rune, such as a space at the end
+			// of a line, don't show that the host echoes keys.
+			if pred.r != pred.under {
+				p.confirmed = true
+			}
+			continue
+		}
+		if now.Sub(pred.at) >= p.timeout() {
+			p.preds = nil
+			p.hold = now.Add(p.timeout())
+			p.confirmed = false
+			return
+		}
+		pending = append(pending, pred)
+	}
+	p.preds = pending
+}
+
+// draw draws the predictions underlined, with the cursor after the last,
+// once the host has confirmed one.
+func (p *predictor) draw() {
+	if !p.confirmed {
+		p.screen.HideCursor()
+		return
+	}
+	for _, pred := range p.preds {
+		_, _, style, _ := p.screen.GetContent(pred.x, pred.y)
+		p.screen.SetContent(pred.x, pred.y, pred.r, nil, style.Reverse(false).Underline(true))
+	}
+	if len(p.preds) > 0 {
+		p.screen.ShowCursor(p.x, p.y)
+	} else {
+		p.screen.HideCursor()
+	}
+}
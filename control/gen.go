@@ -0,0 +1,3 @@
+package control
+
+//go:generate protoc -I=. --gogoslick_out=plugins=grpc:. control.proto
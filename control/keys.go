@@ -0,0 +1,111 @@
+package control
+
+import "unicode/utf8"
+
+// keyNames maps the names of keys accepted by SendKeys to the bytes a
+// terminal sends for them.
+var keyNames = map[string]string{
+	"Enter":    "\r",
+	"Tab":      "\t",
+	"BTab":     "\x1b[Z",
+	"Space":    " ",
+	"Escape":   "\x1b",
+	"BSpace":   "\x7f",
+	"Up":       "\x1b[A",
+	"Down":     "\x1b[B",
+	"Right":    "\x1b[C",
+	"Left":     "\x1b[D",
+	"Home":     "\x1b[H",
+	"End":      "\x1b[F",
+	"IC":       "\x1b[2~",
+	"Insert":   "\x1b[2~",
+	"DC":       "\x1b[3~",
+	"Delete":   "\x1b[3~",
+	"PPage":    "\x1b[5~",
+	"PageUp":   "\x1b[5~",
+	"NPage":    "\x1b[6~",
+	"PageDown": "\x1b[6~",
+	"F1":       "\x1bOP",
+	"F2":       "\x1bOQ",
+	"F3":       "\x1bOR",
+	"F4":       "\x1bOS",
+	"F5":       "\x1b[15~",
+	"F6":       "\x1b[17~",
+	"F7":       "\x1b[18~",
+	"F8":       "\x1b[19~",
+	"F9":       "\x1b[20~",
+	"F10":      "\x1b[21~",
+	"F11":      "\x1b[23~",
+	"F12":      "\x1b[24~",
+}
+
+// Keys converts keys into the bytes a terminal sends when they are typed.
+// Unless literal is true, each key may be a key name such as "Enter", or a
+// character or key name prefixed with "C-" for control or "M-" for meta, as
+// in "C-c" or "M-Left". Keys that are none of these are typed as text.
+func Keys(keys []string, literal bool) []byte {
+	var b []byte
+	for _, key := range keys {
+		if literal {
+			b = append(b, key...)
+			continue
+		}
+		seq, ok := keySequence(key)
+		if !ok {
+			seq = key
+		}
+		b = append(b, seq...)
+	}
+	return b
+}
+
+func keySequence(key string) (string, bool) {
+	var ctrl, meta bool
+	for len(key) > 2 && key[1] == '-' && (key[0] == 'C' || key[0] == 'M') {
+		if key[0] == 'C' {
+			ctrl = true
+		} else {
+			meta = true
+		}
+		key = key[2:]
+	}
+
+	seq, ok := keyNames[key]
+	switch {
+	case ok && ctrl:
+		// Control is only defined for characters.
+		return "", false
+	case ok:
+	case utf8.RuneCountInString(key) == 1:
+		seq = key
+		if ctrl {
+			c, ok := controlChar(key[0])
+			if !ok {
+				return "", false
+			}
+			seq = string(c)
+		}
+	default:
+		return "", false
+	}
+
+	if meta {
+		seq = "\x1b" + seq
+	}
+	return seq, true
+}
+
+// controlChar returns the character typed when c is pressed with control.
+func controlChar(c byte) (byte, bool) {
+	switch {
+	case c >= 'a' && c <= 'z':
+		return c - 'a' + 1, true
+	case c >= '@' && c <= '_':
+		return c - '@', true
+	case c == ' ':
+		return 0, true
+	case c == '?':
+		return 0x7f, true
+	}
+	return 0, false
+}
@@ -0,0 +1,31 @@
+package control
+
+import "testing"
+
+func TestKeys(t *testing.T) {
+	for _, tc := range []struct {
+		keys    []string
+		literal bool
+		want    string
+	}{
+		{[]string{"make test", "Enter"}, false, "make test\r"},
+		{[]string{"C-c"}, false, "\x03"},
+		{[]string{"C-a", "C-z"}, false, "\x01\x1a"},
+		{[]string{"C-@", "C-[", "C-_", "C-Space"}, false, "\x00\x1b\x1fC-Space"},
+		{[]string{"C- ", "C-?"}, false, "\x00\x7f"},
+		{[]string{"M-x"}, false, "\x1bx"},
+		{[]string{"M-Left"}, false, "\x1b\x1b[D"},
+		{[]string{"C-M-a", "M-C-a"}, false, "\x1b\x01\x1b\x01"},
+		{[]string{"C-Enter"}, false, "C-Enter"},
+		{[]string{"C-1"}, false, "C-1"},
+		{[]string{"C-"}, false, "C-"},
+		{[]string{"F1", "F12", "PageUp", "NPage"}, false, "\x1bOP\x1b[24~\x1b[5~\x1b[6~"},
+		{[]string{"é", "M-é"}, false, "é\x1bé"},
+		{[]string{"Enter", "C-c"}, true, "EnterC-c"},
+		{nil, false, ""},
+	} {
+		if got := string(Keys(tc.keys, tc.literal)); got != tc.want {
+			t.Errorf("%q (literal %t): expected %q, got %q", tc.keys, tc.literal, tc.want, got)
+		}
+	}
+}
@@ -0,0 +1,126 @@
+package control
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"syscall"
+
+	"google.golang.org/grpc"
+)
+
+// SocketDir returns the directory control sockets are created in, which is
+// ptmux under $XDG_RUNTIME_DIR, or a per-user directory under the temporary
+// directory if it is unset.
+func SocketDir() string {
+	if dir := os.Getenv("XDG_RUNTIME_DIR"); dir != "" {
+		return filepath.Join(dir, "ptmux")
+	}
+	return filepath.Join(os.TempDir(), fmt.Sprintf("ptmux-%d", os.Getuid()))
+}
+
+// SocketPath returns the path of the control socket of the session name.
+func SocketPath(name string) (string, error) {
+	if name == "" || filepath.Base(name) != name {
+		return "", fmt.Errorf("invalid session name %q", name)
+	}
+	return filepath.Join(SocketDir(), name+".sock"), nil
+}
+
+// Listen listens on the control socket of the session name, removing the
+// socket of a previous session of the same name that is no longer running.
+func Listen(name string) (net.Listener, error) {
+	path, err := SocketPath(name)
+	if err != nil {
+		return nil, err
+	}
+
+	dir := filepath.Dir(path)
+	err = os.MkdirAll(dir, 0700)
+	if err != nil {
+		return nil, err
+	}
+
+	err = checkSocketDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	conn, err := net.Dial("unix", path)
+	switch {
+	case err == nil:
+		conn.Close()
+		return nil, fmt.Errorf("session %q is already running", name)
+	case errors.Is(err, syscall.ECONNREFUSED):
+		err = os.Remove(path)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	l, err := net.Listen("unix", path)
+	if err != nil {
+		return nil, err
+	}
+
+	err = os.Chmod(path, 0600)
+	if err != nil {
+		l.Close()
+		return nil, err
+	}
+	return l, nil
+}
+
+// checkSocketDir returns an error unless dir is a directory owned by the
+// current user that no one else can access. Without $XDG_RUNTIME_DIR, the
+// socket directory is at a predictable path in the shared temporary directory,
+// so another user could create it first to plant a socket that receives the
+// keys sent to the session.
+func checkSocketDir(dir string) error {
+	fi, err := os.Lstat(dir)
+	if err != nil {
+		return err
+	}
+	if !fi.IsDir() {
+		return fmt.Errorf("socket directory %s is not a directory", dir)
+	}
+
+	st, ok := fi.Sys().(*syscall.Stat_t)
+	if !ok || int(st.Uid) != os.Getuid() {
+		return fmt.Errorf("socket directory %s is not owned by the current user", dir)
+	}
+	if fi.Mode().Perm() != 0700 {
+		return fmt.Errorf("socket directory %s has mode %#o, expected 0700", dir, fi.Mode().Perm())
+	}
+	return nil
+}
+
+// Dial connects to the control socket of the session name.
+func Dial(ctx context.Context, name string) (*grpc.ClientConn, error) {
+	path, err := SocketPath(name)
+	if err != nil {
+		return nil, err
+	}
+
+	err = checkSocketDir(filepath.Dir(path))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, fmt.Errorf("no session %q: %w", name, err)
+		}
+		return nil, err
+	}
+
+	_, err = os.Stat(path)
+	if err != nil {
+		return nil, fmt.Errorf("no session %q: %w", name, err)
+	}
+
+	dialerOpt := grpc.WithContextDialer(func(ctx context.Context, addr string) (net.Conn, error) {
+		var d net.Dialer
+		return d.DialContext(ctx, "unix", path)
+	})
+	return grpc.DialContext(ctx, path, dialerOpt, grpc.WithInsecure())
+}
@@ -0,0 +1,132 @@
+package control
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func withRuntimeDir(t *testing.T) string {
+	t.Helper()
+
+	dir, err := ioutil.TempDir("", "ptmux-control")
+	if err != nil {
+		t.Fatal(err)
+	}
+	prev, ok := os.LookupEnv("XDG_RUNTIME_DIR")
+	os.Setenv("XDG_RUNTIME_DIR", dir)
+	t.Cleanup(func() {
+		if ok {
+			os.Setenv("XDG_RUNTIME_DIR", prev)
+		} else {
+			os.Unsetenv("XDG_RUNTIME_DIR")
+		}
+		os.RemoveAll(dir)
+	})
+	return dir
+}
+
+func TestListenSocketMode(t *testing.T) {
+	withRuntimeDir(t)
+
+	l, err := Listen("test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer l.Close()
+
+	path, err := SocketPath("test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	fi, err := os.Stat(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fi.Mode().Perm() != 0600 {
+		t.Errorf("socket has mode %#o, expected 0600", fi.Mode().Perm())
+	}
+}
+
+func TestCheckSocketDir(t *testing.T) {
+	for _, tc := range []struct {
+		name  string
+		setup func(dir string) error
+		err   string
+	}{{
+		name: "private",
+		setup: func(dir string) error {
+			return os.Mkdir(dir, 0700)
+		},
+	}, {
+		name: "group readable",
+		setup: func(dir string) error {
+			err := os.Mkdir(dir, 0700)
+			if err != nil {
+				return err
+			}
+			return os.Chmod(dir, 0750)
+		},
+		err: "has mode 0750",
+	}, {
+		name: "world writable",
+		setup: func(dir string) error {
+			err := os.Mkdir(dir, 0700)
+			if err != nil {
+				return err
+			}
+			return os.Chmod(dir, 0777)
+		},
+		err: "has mode 0777",
+	}, {
+		name: "symlink",
+		setup: func(dir string) error {
+			target := dir + ".target"
+			err := os.Mkdir(target, 0700)
+			if err != nil {
+				return err
+			}
+			return os.Symlink(target, dir)
+		},
+		err: "is not a directory",
+	}, {
+		name: "file",
+		setup: func(dir string) error {
+			return ioutil.WriteFile(dir, nil, 0600)
+		},
+		err: "is not a directory",
+	}} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			root := withRuntimeDir(t)
+			dir := filepath.Join(root, "ptmux")
+			err := tc.setup(dir)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			err = checkSocketDir(dir)
+			if tc.err == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tc.err) {
+				t.Fatalf("expected error containing %q, got %v", tc.err, err)
+			}
+
+			_, err = Listen("test")
+			if err == nil {
+				t.Fatal("expected Listen to refuse the socket directory")
+			}
+			_, err = Dial(context.Background(), "test")
+			if err == nil {
+				t.Fatal("expected Dial to refuse the socket directory")
+			}
+		})
+	}
+}
@@ -13,12 +13,15 @@ require (
 	github.com/libp2p/go-libp2p-discovery v0.6.0
 	github.com/libp2p/go-libp2p-gostream v0.3.1
 	github.com/libp2p/go-libp2p-kad-dht v0.15.0
-	github.com/multiformats/go-multiaddr v0.5.0 // indirect
+	github.com/mattn/go-runewidth v0.0.10
+	github.com/multiformats/go-multiaddr v0.5.0
 	github.com/rs/zerolog v1.26.1
 	github.com/sirupsen/logrus v1.8.1
 	github.com/urfave/cli/v2 v2.3.0
 	golang.org/x/sync v0.0.0-20210220032951-036812b2e83c
+	golang.org/x/sys v0.0.0-20210816183151-1e6c022a8912
 	google.golang.org/grpc v1.43.0
+	gopkg.in/yaml.v3 v3.0.1
 )
 
 replace github.com/gcla/gowid => github.com/hinshun/gowid v1.3.1-0.20220124231037-1783aa2cea74
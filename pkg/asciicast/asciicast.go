@@ -0,0 +1,170 @@
+// Package asciicast reads and writes terminal recordings in the asciicast v2
+// format used by asciinema.
+//
+// A recording is a header line followed by one event per line, each a JSON
+// array of the seconds since the recording started, the event type and its
+// data:
+//
+//	{"version": 2, "width": 80, "height": 24, "timestamp": 1504467315}
+//	[0.248848, "o", "\u001b[1;31mHello \u001b[32mWorld!\u001b[0m\n"]
+//	[1.001376, "i", "ls\r"]
+package asciicast
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+	"time"
+	"unicode/utf8"
+)
+
+// Event types.
+const (
+	// Output is data written to the terminal.
+	Output = "o"
+	// Input is data typed into the terminal.
+	Input = "i"
+	// Marker is a labelled point in the recording.
+	Marker = "m"
+	// Resize is a change in the size of the terminal, as "COLSxROWS".
+	Resize = "r"
+)
+
+// Header is the first line of a recording.
+type Header struct {
+	Version   int               `json:"version"`
+	Width     int               `json:"width"`
+	Height    int               `json:"height"`
+	Timestamp int64             `json:"timestamp,omitempty"`
+	Title     string            `json:"title,omitempty"`
+	Env       map[string]string `json:"env,omitempty"`
+}
+
+// Writer writes a recording. It is safe for concurrent use.
+type Writer struct {
+	mu     sync.Mutex
+	w      *bufio.Writer
+	closer io.Closer
+	start  time.Time
+
+	// pending is the start of a UTF-8 sequence split across two writes of
+	// output, which can't be encoded in JSON until it is complete.
+	pending []byte
+}
+
+// Create creates the recording at path, replacing any existing file.
+func Create(path string, h Header) (*Writer, error) {
+	f, err := os.Create(path)
+	if err != nil {
+		return nil, err
+	}
+
+	w, err := NewWriter(f, h)
+	if err != nil {
+		f.Close()
+		return nil, err
+	}
+	w.closer = f
+	return w, nil
+}
+
+// NewWriter writes the header of a recording to w. The version and timestamp
+// of h are filled in if unset.
+func NewWriter(w io.Writer, h Header) (*Writer, error) {
+	start := time.Now()
+	if h.Version == 0 {
+		h.Version = 2
+	}
+	if h.Timestamp == 0 {
+		h.Timestamp = start.Unix()
+	}
+
+	dt, err := json.Marshal(h)
+	if err != nil {
+		return nil, err
+	}
+
+	bw := bufio.NewWriter(w)
+	_, err = fmt.Fprintf(bw, "%s\n", dt)
+	if err != nil {
+		return nil, err
+	}
+	return &Writer{
+		w:     bw,
+		start: start,
+	}, bw.Flush()
+}
+
+// WriteOutput records data written to the terminal. Data may end part way
+// through a UTF-8 sequence, which is completed by the next write.
+func (w *Writer) WriteOutput(data []byte) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	data = append(w.pending, data...)
+	n := incompleteRune(data)
+	w.pending = append([]byte(nil), data[len(data)-n:]...)
+	data = data[:len(data)-n]
+	if len(data) == 0 {
+		return nil
+	}
+	return w.write(Output, string(data))
+}
+
+// WriteEvent records an event of type typ.
+func (w *Writer) WriteEvent(typ, data string) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.write(typ, data)
+}
+
+// WriteResize records a change in the size of the terminal.
+func (w *Writer) WriteResize(cols, rows int) error {
+	return w.WriteEvent(Resize, fmt.Sprintf("%dx%d", cols, rows))
+}
+
+func (w *Writer) write(typ, data string) error {
+	elapsed := time.Since(w.start).Seconds()
+	dt, err := json.Marshal([]interface{}{elapsed, typ, data})
+	if err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprintf(w.w, "%s\n", dt)
+	if err != nil {
+		return err
+	}
+	return w.w.Flush()
+}
+
+// Close closes the recording.
+func (w *Writer) Close() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	err := w.w.Flush()
+	if w.closer != nil {
+		cerr := w.closer.Close()
+		if err == nil {
+			err = cerr
+		}
+	}
+	return err
+}
+
+// incompleteRune returns the length of an incomplete UTF-8 sequence at the end
+// of p.
+func incompleteRune(p []byte) int {
+	for i := 1; i <= utf8.UTFMax && i <= len(p); i++ {
+		if utf8.RuneStart(p[len(p)-i]) {
+			if utf8.FullRune(p[len(p)-i:]) {
+				return 0
+			}
+			return i
+		}
+	}
+	return 0
+}
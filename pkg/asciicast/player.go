@@ -0,0 +1,260 @@
+package asciicast
+
+import (
+	"context"
+	"io"
+	"strings"
+	"sync"
+	"time"
+)
+
+// reset is written to the terminal before seeking backwards, so the
+// recording can be replayed from the start up to the new position. The
+// screen is erased explicitly, as not every terminal emulator clears all of
+// it on a reset.
+const reset = "\x1bc\x1b[2J"
+
+// Player replays the output of a recording to a terminal at the pace it was
+// recorded, scaled by its speed. It is safe for concurrent use.
+type Player struct {
+	rec    *Recording
+	w      io.Writer
+	resize func(cols, rows int)
+
+	mu     sync.Mutex
+	next   int
+	at     time.Duration
+	wall   time.Time
+	paused bool
+	speed  float64
+	marker string
+	wake   chan struct{}
+}
+
+// NewPlayer returns a player that writes the output of rec to w. resize is
+// called with the size of the terminal when the recording starts and at each
+// resize event.
+func NewPlayer(rec *Recording, w io.Writer, resize func(cols, rows int)) *Player {
+	return &Player{
+		rec:    rec,
+		w:      w,
+		resize: resize,
+		wall:   time.Now(),
+		speed:  1,
+		wake:   make(chan struct{}, 1),
+	}
+}
+
+// Play plays the recording until ctx is done. Once the end is reached, it
+// waits for a seek back into the recording.
+func (p *Player) Play(ctx context.Context) error {
+	p.mu.Lock()
+	p.resize(p.rec.Header.Width, p.rec.Header.Height)
+	p.wall = time.Now()
+	p.mu.Unlock()
+
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+	for {
+		var wait <-chan time.Time
+		p.mu.Lock()
+		for !p.paused && p.next < len(p.rec.Events) {
+			d := p.rec.Events[p.next].Time - p.position()
+			if d > 0 {
+				if !timer.Stop() {
+					select {
+					case <-timer.C:
+					default:
+					}
+				}
+				timer.Reset(time.Duration(float64(d) / p.speed))
+				wait = timer.C
+				break
+			}
+
+			err := p.apply(p.rec.Events[p.next])
+			if err != nil {
+				p.mu.Unlock()
+				return err
+			}
+			p.next++
+		}
+		p.mu.Unlock()
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-p.wake:
+		case <-wait:
+		}
+	}
+}
+
+// apply applies ev to the terminal. It must be called with mu held.
+func (p *Player) apply(ev Event) error {
+	switch ev.Type {
+	case Output:
+		_, err := io.WriteString(p.w, ev.Data)
+		return err
+	case Resize:
+		cols, rows, err := ParseResize(ev.Data)
+		if err == nil {
+			p.resize(cols, rows)
+		}
+	case Marker:
+		p.marker = ev.Data
+	}
+	return nil
+}
+
+// position returns the position in the recording. It must be called with mu
+// held.
+func (p *Player) position() time.Duration {
+	if p.paused {
+		return p.at
+	}
+	return p.at + time.Duration(float64(time.Since(p.wall))*p.speed)
+}
+
+// setPosition moves the clock of the player to pos without replaying
+// anything. It must be called with mu held.
+func (p *Player) setPosition(pos time.Duration) {
+	p.at = pos
+	p.wall = time.Now()
+}
+
+func (p *Player) signal() {
+	select {
+	case p.wake <- struct{}{}:
+	default:
+	}
+}
+
+// Position returns the position in the recording.
+func (p *Player) Position() time.Duration {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	pos := p.position()
+	if d := p.rec.Duration(); pos > d {
+		pos = d
+	}
+	return pos
+}
+
+// Duration returns the length of the recording.
+func (p *Player) Duration() time.Duration {
+	return p.rec.Duration()
+}
+
+// Ended returns true once every event has been played.
+func (p *Player) Ended() bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.next >= len(p.rec.Events)
+}
+
+// Marker returns the label of the last marker played, such as the peer that
+// was typing.
+func (p *Player) Marker() string {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.marker
+}
+
+// Paused returns true if playback is paused.
+func (p *Player) Paused() bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.paused
+}
+
+// SetPaused pauses or resumes playback.
+func (p *Player) SetPaused(paused bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if paused == p.paused {
+		return
+	}
+	p.setPosition(p.position())
+	p.paused = paused
+	p.signal()
+}
+
+// Speed returns how many times faster than recorded the recording is played.
+func (p *Player) Speed() float64 {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.speed
+}
+
+// SetSpeed plays the recording speed times faster than it was recorded.
+func (p *Player) SetSpeed(speed float64) {
+	if speed <= 0 {
+		return
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.setPosition(p.position())
+	p.speed = speed
+	p.signal()
+}
+
+// Seek moves playback to pos. Everything up to pos is written to the terminal
+// at once, and seeking backwards resets the terminal and replays the
+// recording from the start.
+func (p *Player) Seek(pos time.Duration) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if pos < 0 {
+		pos = 0
+	}
+	if d := p.rec.Duration(); pos > d {
+		pos = d
+	}
+
+	if pos < p.position() {
+		_, err := io.WriteString(p.w, reset)
+		if err != nil {
+			return err
+		}
+		p.resize(p.rec.Header.Width, p.rec.Header.Height)
+		p.next = 0
+		p.marker = ""
+	}
+
+	// Coalesce the output being skipped over into a single write.
+	var out strings.Builder
+	for ; p.next < len(p.rec.Events) && p.rec.Events[p.next].Time <= pos; p.next++ {
+		ev := p.rec.Events[p.next]
+		if ev.Type == Output {
+			out.WriteString(ev.Data)
+			continue
+		}
+		if ev.Type == Resize && out.Len() > 0 {
+			_, err := io.WriteString(p.w, out.String())
+			if err != nil {
+				return err
+			}
+			out.Reset()
+		}
+		err := p.apply(ev)
+		if err != nil {
+			return err
+		}
+	}
+	if out.Len() > 0 {
+		_, err := io.WriteString(p.w, out.String())
+		if err != nil {
+			return err
+		}
+	}
+
+	p.setPosition(pos)
+	p.signal()
+	return nil
+}
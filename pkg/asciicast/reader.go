@@ -0,0 +1,129 @@
+package asciicast
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"time"
+)
+
+// Event is a single event of a recording.
+type Event struct {
+	// Time is the time since the recording started.
+	Time time.Duration
+	Type string
+	Data string
+}
+
+// UnmarshalJSON decodes an event from its array form.
+func (e *Event) UnmarshalJSON(dt []byte) error {
+	var fields []json.RawMessage
+	err := json.Unmarshal(dt, &fields)
+	if err != nil {
+		return err
+	}
+	if len(fields) != 3 {
+		return fmt.Errorf("expected 3 fields in event, got %d", len(fields))
+	}
+
+	var secs float64
+	err = json.Unmarshal(fields[0], &secs)
+	if err != nil {
+		return fmt.Errorf("invalid event time: %w", err)
+	}
+	e.Time = time.Duration(secs * float64(time.Second))
+
+	err = json.Unmarshal(fields[1], &e.Type)
+	if err != nil {
+		return fmt.Errorf("invalid event type: %w", err)
+	}
+	return json.Unmarshal(fields[2], &e.Data)
+}
+
+// Recording is a recording read into memory.
+type Recording struct {
+	Header Header
+	Events []Event
+}
+
+// Duration returns the time of the last event of the recording.
+func (r *Recording) Duration() time.Duration {
+	if len(r.Events) == 0 {
+		return 0
+	}
+	return r.Events[len(r.Events)-1].Time
+}
+
+// Open reads the recording at path.
+func Open(path string) (*Recording, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	rec, err := Read(f)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return rec, nil
+}
+
+// Read reads a recording from r. Events are sorted by time, as recordings
+// written by more than one writer may not be.
+func Read(r io.Reader) (*Recording, error) {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), 64*1024*1024)
+
+	if !scanner.Scan() {
+		if err := scanner.Err(); err != nil {
+			return nil, err
+		}
+		return nil, fmt.Errorf("missing header")
+	}
+
+	var rec Recording
+	err := json.Unmarshal(scanner.Bytes(), &rec.Header)
+	if err != nil {
+		return nil, fmt.Errorf("invalid header: %w", err)
+	}
+	if rec.Header.Version != 2 {
+		return nil, fmt.Errorf("unsupported asciicast version %d", rec.Header.Version)
+	}
+	if rec.Header.Width <= 0 || rec.Header.Height <= 0 {
+		return nil, fmt.Errorf("invalid size %dx%d", rec.Header.Width, rec.Header.Height)
+	}
+
+	for line := 2; scanner.Scan(); line++ {
+		if len(scanner.Bytes()) == 0 {
+			continue
+		}
+
+		var ev Event
+		err = json.Unmarshal(scanner.Bytes(), &ev)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+		rec.Events = append(rec.Events, ev)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	sort.SliceStable(rec.Events, func(i, j int) bool {
+		return rec.Events[i].Time < rec.Events[j].Time
+	})
+	return &rec, nil
+}
+
+// ParseResize returns the size of the terminal in the data of a resize event.
+func ParseResize(data string) (cols, rows int, err error) {
+	_, err = fmt.Sscanf(data, "%dx%d", &cols, &rows)
+	if err != nil || cols <= 0 || rows <= 0 {
+		return 0, 0, fmt.Errorf("invalid resize %q", data)
+	}
+	return cols, rows, nil
+}
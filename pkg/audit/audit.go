@@ -0,0 +1,203 @@
+// Package audit keeps an append-only log of the input remote peers send to a
+// session, as one JSON object per line.
+//
+//	{"time":"2022-01-27T10:04:05.123Z","peer":"12D3KooW...","pane":"%1","event":"key","keys":"l"}
+//	{"time":"2022-01-27T10:04:07.456Z","peer":"12D3KooW...","pane":"%1","event":"key","redacted":true}
+//
+// When the log grows past its maximum size, it is rotated: path is renamed to
+// path.1, path.1 to path.2 and so on, and the oldest file is removed.
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog"
+)
+
+// Defaults for the size and number of audit logs kept.
+const (
+	DefaultMaxSize  = 100 << 20
+	DefaultMaxFiles = 5
+)
+
+// Entry is a single event sent by a remote peer.
+type Entry struct {
+	Time time.Time `json:"time"`
+
+	// Peer is the ID the peer was authenticated as by the transport.
+	Peer string `json:"peer"`
+
+	// Claimed is the ID the peer gave for itself, if it differs from Peer.
+	Claimed string `json:"claimed,omitempty"`
+
+	// Pane is the stable ID of the pane the event was applied to, such as
+	// "%1", or empty if it didn't reach a pane.
+	Pane string `json:"pane,omitempty"`
+
+	// Event is the kind of event: "key", "mouse", "paste", "resize" or
+	// "select-pane".
+	Event string `json:"event"`
+
+	// Keys are the keys typed, such as "l" or "Ctrl+C".
+	Keys string `json:"keys,omitempty"`
+
+	// Redacted is true if the keys were left out because the terminal of
+	// the pane was reading a line without echoing it, as at a password
+	// prompt.
+	Redacted bool `json:"redacted,omitempty"`
+
+	// Detail describes events other than keys, such as the position of the
+	// mouse.
+	Detail string `json:"detail,omitempty"`
+}
+
+// Options configures an audit log.
+type Options struct {
+	// MaxSize is the size in bytes the log may grow to before it is rotated.
+	// Zero means DefaultMaxSize.
+	MaxSize int64
+
+	// MaxFiles is the number of rotated logs kept besides the current one.
+	// Zero means DefaultMaxFiles.
+	MaxFiles int
+
+	// Redact leaves out keys typed at password prompts.
+	Redact bool
+}
+
+// Log is an audit log. It is safe for concurrent use, and a nil *Log discards
+// every entry.
+type Log struct {
+	ctx  context.Context
+	path string
+	opts Options
+
+	mu   sync.Mutex
+	f    *os.File
+	size int64
+}
+
+// Open opens the audit log at path for appending, creating it if needed.
+// Errors writing to it are logged to the logger of ctx.
+func Open(ctx context.Context, path string, opts Options) (*Log, error) {
+	if opts.MaxSize <= 0 {
+		opts.MaxSize = DefaultMaxSize
+	}
+	if opts.MaxFiles <= 0 {
+		opts.MaxFiles = DefaultMaxFiles
+	}
+
+	l := &Log{ctx: ctx, path: path, opts: opts}
+	err := l.open()
+	if err != nil {
+		return nil, err
+	}
+	return l, nil
+}
+
+func (l *Log) open() error {
+	f, err := os.OpenFile(l.path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0600)
+	if err != nil {
+		return err
+	}
+
+	fi, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return err
+	}
+	l.f = f
+	l.size = fi.Size()
+	return nil
+}
+
+// Redact returns true if keys typed at password prompts should be left out.
+func (l *Log) Redact() bool {
+	return l != nil && l.opts.Redact
+}
+
+// Write appends e to the log, rotating it first if it would grow past its
+// maximum size.
+func (l *Log) Write(e Entry) {
+	if l == nil {
+		return
+	}
+
+	err := l.write(e)
+	if err != nil {
+		zerolog.Ctx(l.ctx).Error().Err(err).Str("path", l.path).Msg("unable to write audit log")
+	}
+}
+
+func (l *Log) write(e Entry) error {
+	dt, err := json.Marshal(e)
+	if err != nil {
+		return err
+	}
+	dt = append(dt, '\n')
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.f == nil {
+		return fmt.Errorf("audit log %s is closed", l.path)
+	}
+	if l.size > 0 && l.size+int64(len(dt)) > l.opts.MaxSize {
+		err = l.rotate()
+		if err != nil {
+			return err
+		}
+	}
+
+	n, err := l.f.Write(dt)
+	l.size += int64(n)
+	return err
+}
+
+// rotate renames the current log to path.1, shifting older logs up and
+// removing the oldest, then opens a new log. It must be called with mu held.
+func (l *Log) rotate() error {
+	err := l.f.Close()
+	l.f = nil
+	if err != nil {
+		return err
+	}
+
+	err = os.Remove(fmt.Sprintf("%s.%d", l.path, l.opts.MaxFiles))
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	for i := l.opts.MaxFiles - 1; i >= 1; i-- {
+		err = os.Rename(fmt.Sprintf("%s.%d", l.path, i), fmt.Sprintf("%s.%d", l.path, i+1))
+		if err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+	err = os.Rename(l.path, l.path+".1")
+	if err != nil {
+		return err
+	}
+	return l.open()
+}
+
+// Close closes the log.
+func (l *Log) Close() error {
+	if l == nil {
+		return nil
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.f == nil {
+		return nil
+	}
+	err := l.f.Close()
+	l.f = nil
+	return err
+}
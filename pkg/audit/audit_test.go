@@ -0,0 +1,166 @@
+package audit
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func entry(i int) Entry {
+	return Entry{Peer: "peer", Event: "key", Keys: fmt.Sprint(i)}
+}
+
+// readKeys returns the keys of the entries in each log file, from the current
+// log to the oldest, stopping at the first that doesn't exist.
+func readKeys(t *testing.T, path string) [][]string {
+	t.Helper()
+
+	var files [][]string
+	for i := 0; ; i++ {
+		name := path
+		if i > 0 {
+			name = fmt.Sprintf("%s.%d", path, i)
+		}
+		f, err := os.Open(name)
+		if os.IsNotExist(err) {
+			return files
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		keys := []string{}
+		s := bufio.NewScanner(f)
+		for s.Scan() {
+			var e Entry
+			err = json.Unmarshal(s.Bytes(), &e)
+			if err != nil {
+				t.Fatalf("%s: %s", name, err)
+			}
+			keys = append(keys, e.Keys)
+		}
+		f.Close()
+		files = append(files, keys)
+	}
+}
+
+func TestRotate(t *testing.T) {
+	dt, err := json.Marshal(entry(0))
+	if err != nil {
+		t.Fatal(err)
+	}
+	line := int64(len(dt) + 1)
+
+	for _, tc := range []struct {
+		name     string
+		maxLines int64
+		maxFiles int
+		writes   []int
+		reopen   bool
+		files    [][]string
+	}{{
+		name:     "under the limit",
+		maxLines: 2,
+		maxFiles: 2,
+		writes:   []int{1, 2},
+		files:    [][]string{{"1", "2"}},
+	}, {
+		name:     "rotated",
+		maxLines: 2,
+		maxFiles: 2,
+		writes:   []int{1, 2, 3},
+		files:    [][]string{{"3"}, {"1", "2"}},
+	}, {
+		name:     "oldest removed",
+		maxLines: 2,
+		maxFiles: 2,
+		writes:   []int{1, 2, 3, 4, 5, 6, 7},
+		files:    [][]string{{"7"}, {"5", "6"}, {"3", "4"}},
+	}, {
+		name:     "entries larger than the limit",
+		maxLines: 0,
+		maxFiles: 1,
+		writes:   []int{1, 2, 3},
+		files:    [][]string{{"3"}, {"2"}},
+	}, {
+		name:     "reopened",
+		maxLines: 2,
+		maxFiles: 2,
+		writes:   []int{1, 2, 3},
+		reopen:   true,
+		files:    [][]string{{"3"}, {"1", "2"}},
+	}} {
+		dir, err := ioutil.TempDir("", "ptmux-audit")
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer os.RemoveAll(dir)
+		path := filepath.Join(dir, "audit.jsonl")
+
+		// A size of less than one entry still rotates after every entry.
+		maxSize := tc.maxLines * line
+		if maxSize == 0 {
+			maxSize = 1
+		}
+		opts := Options{MaxSize: maxSize, MaxFiles: tc.maxFiles}
+
+		l, err := Open(context.Background(), path, opts)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, i := range tc.writes {
+			// Reopening picks up the size of the existing log.
+			if tc.reopen {
+				l.Close()
+				l, err = Open(context.Background(), path, opts)
+				if err != nil {
+					t.Fatal(err)
+				}
+			}
+			err = l.write(entry(i))
+			if err != nil {
+				t.Fatalf("%s: %s", tc.name, err)
+			}
+		}
+		l.Close()
+
+		if got := readKeys(t, path); !reflect.DeepEqual(got, tc.files) {
+			t.Errorf("%s: expected %v, got %v", tc.name, tc.files, got)
+		}
+	}
+}
+
+func TestClosedAndNilLog(t *testing.T) {
+	dir, err := ioutil.TempDir("", "ptmux-audit")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	l, err := Open(context.Background(), filepath.Join(dir, "audit.jsonl"), Options{Redact: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !l.Redact() {
+		t.Error("expected the log to redact")
+	}
+	l.Close()
+	if err := l.write(entry(1)); err == nil {
+		t.Error("expected an error writing to a closed log")
+	}
+
+	var nl *Log
+	nl.Write(entry(1))
+	if nl.Redact() {
+		t.Error("expected a nil log not to redact")
+	}
+	if err := nl.Close(); err != nil {
+		t.Error(err)
+	}
+}
@@ -0,0 +1,199 @@
+// Package hooks runs user-configured shell commands when something happens in
+// a session, such as a peer attaching or a pane exiting.
+//
+// Each hook is run with $SHELL -c and is told about the event through its
+// environment:
+//
+//	PTMUX_EVENT        the event, e.g. "peer-join"
+//	PTMUX_SESSION      the name of the session
+//	PTMUX_PEER         the peer that joined or left, as authenticated by the
+//	                   transport
+//	PTMUX_PEER_CLAIMED the ID the peer gave itself, which it may choose freely
+//	PTMUX_PANE         the stable ID of the pane, e.g. "%1"
+//	PTMUX_PANE_TITLE   the title of the pane
+//	PTMUX_EXIT_CODE    the exit code of the process in the pane
+//	PTMUX_EXIT_SIGNAL  the signal that killed the process in the pane
+package hooks
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog"
+)
+
+// Event is something that happens in a session that hooks can run on.
+type Event string
+
+const (
+	SessionStart Event = "session-start"
+	SessionStop  Event = "session-stop"
+	PeerJoin     Event = "peer-join"
+	PeerLeave    Event = "peer-leave"
+	PaneCreate   Event = "pane-create"
+	PaneExit     Event = "pane-exit"
+	PaneTitle    Event = "pane-title"
+)
+
+// Events are the events hooks can run on.
+var Events = []Event{
+	SessionStart,
+	SessionStop,
+	PeerJoin,
+	PeerLeave,
+	PaneCreate,
+	PaneExit,
+	PaneTitle,
+}
+
+// Timeout is how long a hook may run before it is killed.
+var Timeout = 10 * time.Second
+
+// Runner is implemented by screens that run hooks, so that widgets can run
+// hooks through the app they are given.
+type Runner interface {
+	RunHook(ev Event, vars map[string]string)
+}
+
+// Valid returns true if event is the name of an event.
+func Valid(event string) bool {
+	for _, ev := range Events {
+		if string(ev) == event {
+			return true
+		}
+	}
+	return false
+}
+
+// Parse parses hooks of the form "event=command".
+func Parse(hooks []string) (map[string][]string, error) {
+	commands := make(map[string][]string)
+	for _, hook := range hooks {
+		i := strings.IndexByte(hook, '=')
+		if i < 0 {
+			return nil, fmt.Errorf("hook %q must be of the form EVENT=COMMAND", hook)
+		}
+		event, command := hook[:i], hook[i+1:]
+		if !Valid(event) {
+			return nil, fmt.Errorf("hook %q: unknown event %q", hook, event)
+		}
+		commands[event] = append(commands[event], command)
+	}
+	return commands, nil
+}
+
+// Hooks runs the commands configured for each event. A nil *Hooks runs
+// nothing.
+type Hooks struct {
+	ctx      context.Context
+	session  string
+	commands map[Event][]string
+	wg       sync.WaitGroup
+}
+
+// New returns the hooks of the session, merging the commands for each event
+// in order. A command given more than once for the same event is only run
+// once.
+func New(ctx context.Context, session string, commands ...map[string][]string) (*Hooks, error) {
+	h := &Hooks{
+		ctx:      ctx,
+		session:  session,
+		commands: make(map[Event][]string),
+	}
+	for _, m := range commands {
+		for event, cmds := range m {
+			if !Valid(event) {
+				return nil, fmt.Errorf("unknown hook event %q", event)
+			}
+			ev := Event(event)
+			for _, cmd := range cmds {
+				if !contains(h.commands[ev], cmd) {
+					h.commands[ev] = append(h.commands[ev], cmd)
+				}
+			}
+		}
+	}
+	return h, nil
+}
+
+// Commands returns the commands run for each event.
+func (h *Hooks) Commands() map[string][]string {
+	if h == nil || len(h.commands) == 0 {
+		return nil
+	}
+	commands := make(map[string][]string)
+	for ev, cmds := range h.commands {
+		commands[string(ev)] = append([]string(nil), cmds...)
+	}
+	return commands
+}
+
+// Run starts the commands for ev in the background, with vars added to their
+// environment.
+func (h *Hooks) Run(ev Event, vars map[string]string) {
+	if h == nil || len(h.commands[ev]) == 0 {
+		return
+	}
+
+	env := append(os.Environ(),
+		"PTMUX_EVENT="+string(ev),
+		"PTMUX_SESSION="+h.session,
+	)
+	keys := make([]string, 0, len(vars))
+	for k := range vars {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		env = append(env, k+"="+vars[k])
+	}
+
+	shell := os.Getenv("SHELL")
+	if shell == "" {
+		shell = "/bin/sh"
+	}
+
+	for _, command := range h.commands[ev] {
+		h.wg.Add(1)
+		go func(command string) {
+			defer h.wg.Done()
+
+			ctx, cancel := context.WithTimeout(h.ctx, Timeout)
+			defer cancel()
+
+			cmd := exec.CommandContext(ctx, shell, "-c", command)
+			cmd.Env = env
+			out, err := cmd.CombinedOutput()
+			if err != nil {
+				zerolog.Ctx(h.ctx).Error().Err(err).
+					Str("event", string(ev)).
+					Str("command", command).
+					Bytes("output", out).
+					Msg("hook failed")
+			}
+		}(command)
+	}
+}
+
+// Wait waits for every running hook to finish.
+func (h *Hooks) Wait() {
+	if h == nil {
+		return
+	}
+	h.wg.Wait()
+}
+
+func contains(s []string, v string) bool {
+	for _, e := range s {
+		if e == v {
+			return true
+		}
+	}
+	return false
+}
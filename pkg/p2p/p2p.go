@@ -3,6 +3,7 @@ package p2p
 import (
 	"context"
 	"fmt"
+	"sync"
 
 	libp2p "github.com/libp2p/go-libp2p"
 	"github.com/libp2p/go-libp2p-core/discovery"
@@ -13,6 +14,7 @@ import (
 	gdiscovery "github.com/libp2p/go-libp2p-discovery"
 	dht "github.com/libp2p/go-libp2p-kad-dht"
 	"github.com/libp2p/go-libp2p/p2p/protocol/holepunch"
+	ma "github.com/multiformats/go-multiaddr"
 	"github.com/rs/zerolog"
 )
 
@@ -32,14 +34,27 @@ func init() {
 	DefaultBootstrapPeers = append(DefaultBootstrapPeers, *relay)
 }
 
+// How a peer is connected, as returned by ConnType.
+const (
+	ConnDirect      = "direct"
+	ConnHolePunched = "hole-punched"
+	ConnRelayed     = "relayed"
+)
+
 type Peer struct {
 	host.Host
 	DHT       *dht.IpfsDHT
 	Discovery discovery.Discovery
+
+	holepunch *holepunchTracer
 }
 
 func New(ctx context.Context) (*Peer, error) {
 	var idht *dht.IpfsDHT
+	tracer := &holepunchTracer{
+		log:     zerolog.Ctx(ctx),
+		punched: make(map[peer.ID]bool),
+	}
 	host, err := libp2p.New(
 		libp2p.Defaults,
 		// Let this host use relays and advertise itself on relays if
@@ -52,7 +67,7 @@ func New(ctx context.Context) (*Peer, error) {
 		// EnableHolePunching enables NAT traversal by enabling NATT'd peers to both
 		// initiate and respond to hole punching attempts to create direct /
 		// NAT-traversed connections with other peers.
-		libp2p.EnableHolePunching(holepunch.WithTracer(&holepunchTracer{zerolog.Ctx(ctx)})),
+		libp2p.EnableHolePunching(holepunch.WithTracer(tracer)),
 		// Attempt to open ports using uPNP for NATed hosts.
 		libp2p.NATPortMap(),
 		// Let this host use the DHT to find other hosts
@@ -70,8 +85,11 @@ func New(ctx context.Context) (*Peer, error) {
 		ConnectedF: func(_ network.Network, conn network.Conn) {
 			zerolog.Ctx(ctx).Info().Msgf("Connected to %s [%s]", conn.RemotePeer(), conn.RemoteMultiaddr())
 		},
-		DisconnectedF: func(_ network.Network, conn network.Conn) {
+		DisconnectedF: func(n network.Network, conn network.Conn) {
 			zerolog.Ctx(ctx).Info().Msgf("Disconnected from %s [%s]", conn.RemotePeer(), conn.RemoteMultiaddr())
+			if n.Connectedness(conn.RemotePeer()) != network.Connected {
+				tracer.forget(conn.RemotePeer())
+			}
 		},
 	})
 
@@ -101,17 +119,65 @@ func New(ctx context.Context) (*Peer, error) {
 		Host:      host,
 		DHT:       idht,
 		Discovery: gdiscovery.NewRoutingDiscovery(idht),
+		holepunch: tracer,
 	}, nil
 }
 
+// ConnType returns how the peer id is connected: directly, through a hole
+// punched in a NAT, or through a relay. Direct connections are preferred
+// when there are several, as libp2p prefers them for new streams. It returns
+// an empty string if the peer isn't connected.
+func (p *Peer) ConnType(id peer.ID) string {
+	connType := ""
+	for _, conn := range p.Network().ConnsToPeer(id) {
+		if isRelayed(conn) {
+			connType = ConnRelayed
+			continue
+		}
+		if p.holepunch.holePunched(id) {
+			return ConnHolePunched
+		}
+		return ConnDirect
+	}
+	return connType
+}
+
+func isRelayed(conn network.Conn) bool {
+	if conn.Stat().Transient {
+		return true
+	}
+	_, err := conn.RemoteMultiaddr().ValueForProtocol(ma.P_CIRCUIT)
+	return err == nil
+}
+
+// holepunchTracer logs hole punching and remembers the peers a hole was
+// punched to, until they disconnect.
 type holepunchTracer struct {
 	log *zerolog.Logger
+
+	mu      sync.Mutex
+	punched map[peer.ID]bool
+}
+
+func (ht *holepunchTracer) holePunched(id peer.ID) bool {
+	ht.mu.Lock()
+	defer ht.mu.Unlock()
+	return ht.punched[id]
+}
+
+func (ht *holepunchTracer) forget(id peer.ID) {
+	ht.mu.Lock()
+	defer ht.mu.Unlock()
+	delete(ht.punched, id)
 }
 
 func (ht *holepunchTracer) Trace(evt *holepunch.Event) {
 	switch v := evt.Evt.(type) {
 	case *holepunch.EndHolePunchEvt:
 		if v.Success {
+			ht.mu.Lock()
+			ht.punched[evt.Remote] = true
+			ht.mu.Unlock()
 			ht.log.Info().Msgf("Hole punched %s->%s", evt.Peer, evt.Remote)
 		} else {
 			ht.log.Info().Msgf("Unable to holepunch %s->%s: %s", evt.Peer, evt.Remote, v.Error)
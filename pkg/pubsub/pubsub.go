@@ -1,36 +1,112 @@
+// Package pubsub publishes messages on topics to subscribers without ever
+// waiting on them. Each subscriber has a queue of its own, delivered to its
+// channel in the background, and a Policy deciding what happens to messages
+// published while its queue is full.
 package pubsub
 
-import "sync"
+import (
+	"sync"
+	"sync/atomic"
+)
+
+// DefaultQueueSize is the number of messages queued for a subscriber if its
+// options don't say.
+const DefaultQueueSize = 64
+
+// Policy is what happens to a message published to a subscriber whose queue
+// is full.
+type Policy int
+
+const (
+	// DropOldest drops the oldest message queued to make room.
+	DropOldest Policy = iota
+
+	// CoalesceLatest keeps only the latest message, for notifications where
+	// only the latest matters, such as that the screen needs rendering. The
+	// queue never holds more than one message.
+	CoalesceLatest
+
+	// Disconnect unsubscribes the subscriber, closing its channel.
+	Disconnect
+)
+
+// Options configures a subscriber.
+type Options struct {
+	Policy Policy
+
+	// QueueSize is the number of messages queued for the subscriber besides
+	// those buffered by its channel. Zero means DefaultQueueSize.
+	QueueSize int
+}
+
+// Stats counts what happened to the messages published to a subscriber.
+type Stats struct {
+	// Published is the number of messages published to the subscriber.
+	Published uint64
+
+	// Delivered is the number of messages sent on its channel.
+	Delivered uint64
+
+	// Dropped is the number of messages dropped because its queue was full,
+	// including those still queued when it was disconnected.
+	Dropped uint64
+
+	// Coalesced is the number of messages replaced by a later message.
+	Coalesced uint64
+
+	// Disconnected is the number of times it was disconnected for falling
+	// behind.
+	Disconnected uint64
+}
+
+func (s *Stats) add(o Stats) {
+	s.Published += o.Published
+	s.Delivered += o.Delivered
+	s.Dropped += o.Dropped
+	s.Coalesced += o.Coalesced
+	s.Disconnected += o.Disconnected
+}
 
 type Pubsub struct {
 	mu     sync.RWMutex
-	subs   map[string]map[string]chan string
+	subs   map[string]map[string]*subscriber
 	closed bool
+
+	// totals keeps the stats of subscribers that unsubscribed, by topic.
+	totals map[string]Stats
 }
 
 func New() *Pubsub {
 	return &Pubsub{
-		subs: make(map[string]map[string]chan string),
+		subs:   make(map[string]map[string]*subscriber),
+		totals: make(map[string]Stats),
 	}
 }
 
-func (ps *Pubsub) Subscribe(topic, id string, ch chan string) {
+// Subscribe sends the messages published on topic to ch until id
+// unsubscribes or the pubsub is closed, when ch is closed. A previous
+// subscriber with the same id is unsubscribed. If the pubsub is already
+// closed, ch is closed straight away.
+func (ps *Pubsub) Subscribe(topic, id string, ch chan string, opts Options) {
 	ps.mu.Lock()
 	defer ps.mu.Unlock()
 
+	if ps.closed {
+		close(ch)
+		return
+	}
+
 	topicSubs, ok := ps.subs[topic]
 	if !ok {
-		topicSubs = make(map[string]chan string)
+		topicSubs = make(map[string]*subscriber)
+		ps.subs[topic] = topicSubs
 	}
 
-	sub, ok := topicSubs[id]
-	if ok {
-		close(sub)
-		delete(topicSubs, id)
+	if sub, ok := topicSubs[id]; ok {
+		ps.remove(topic, id, sub)
 	}
 
-	topicSubs[id] = ch
-	ps.subs[topic] = topicSubs
+	topicSubs[id] = newSubscriber(ch, opts)
 }
 
 func (ps *Pubsub) Unsubscribe(topic, id string) {
@@ -41,18 +117,25 @@ func (ps *Pubsub) Unsubscribe(topic, id string) {
 		return
 	}
 
-	topicSubs, ok := ps.subs[topic]
-	if !ok {
-		return
-	}
-
-	ch, ok := topicSubs[id]
+	sub, ok := ps.subs[topic][id]
 	if ok {
-		close(ch)
-		delete(topicSubs, id)
+		ps.remove(topic, id, sub)
 	}
 }
 
+// remove unsubscribes sub, dropping the messages still queued for it. It must
+// be called with mu held.
+func (ps *Pubsub) remove(topic, id string, sub *subscriber) {
+	delete(ps.subs[topic], id)
+	sub.close(false)
+
+	totals := ps.totals[topic]
+	totals.add(sub.stats())
+	ps.totals[topic] = totals
+}
+
+// Publish queues msg for every subscriber of topic without waiting for any of
+// them.
 func (ps *Pubsub) Publish(topic string, msg string) {
 	ps.mu.Lock()
 	defer ps.mu.Unlock()
@@ -61,11 +144,41 @@ func (ps *Pubsub) Publish(topic string, msg string) {
 		return
 	}
 
-	for _, ch := range ps.subs[topic] {
-		ch <- msg
+	for id, sub := range ps.subs[topic] {
+		if !sub.publish(msg) {
+			atomic.AddUint64(&sub.disconnected, 1)
+			ps.remove(topic, id, sub)
+		}
+	}
+}
+
+// Stats returns the stats of each subscriber of topic, by id.
+func (ps *Pubsub) Stats(topic string) map[string]Stats {
+	ps.mu.RLock()
+	defer ps.mu.RUnlock()
+
+	stats := make(map[string]Stats)
+	for id, sub := range ps.subs[topic] {
+		stats[id] = sub.stats()
+	}
+	return stats
+}
+
+// Totals returns the stats of every subscriber topic has had, including those
+// that unsubscribed.
+func (ps *Pubsub) Totals(topic string) Stats {
+	ps.mu.RLock()
+	defer ps.mu.RUnlock()
+
+	totals := ps.totals[topic]
+	for _, sub := range ps.subs[topic] {
+		totals.add(sub.stats())
 	}
+	return totals
 }
 
+// Close closes the channel of every subscriber once the messages queued for
+// it have been delivered.
 func (ps *Pubsub) Close() error {
 	ps.mu.Lock()
 	defer ps.mu.Unlock()
@@ -73,11 +186,155 @@ func (ps *Pubsub) Close() error {
 	if !ps.closed {
 		ps.closed = true
 		for _, subs := range ps.subs {
-			for _, ch := range subs {
-				close(ch)
+			for _, sub := range subs {
+				sub.close(true)
 			}
 		}
 	}
 
 	return nil
 }
+
+// subscriber queues messages for a channel, which are sent on it by a
+// goroutine of its own so that a full channel never holds up publishing.
+type subscriber struct {
+	// Accessed atomically, and first to keep them 64-bit aligned.
+	published    uint64
+	delivered    uint64
+	dropped      uint64
+	coalesced    uint64
+	disconnected uint64
+
+	ch     chan string
+	policy Policy
+	size   int
+
+	mu     sync.Mutex
+	queue  []string
+	closed bool
+
+	// wake is signalled when a message is queued or the subscriber is
+	// closed, and done is closed to stop delivering straight away.
+	wake chan struct{}
+	done chan struct{}
+}
+
+func newSubscriber(ch chan string, opts Options) *subscriber {
+	sub := &subscriber{
+		ch:     ch,
+		policy: opts.Policy,
+		size:   opts.QueueSize,
+		wake:   make(chan struct{}, 1),
+		done:   make(chan struct{}),
+	}
+	if sub.size <= 0 {
+		sub.size = DefaultQueueSize
+	}
+	if sub.policy == CoalesceLatest {
+		sub.size = 1
+	}
+	go sub.deliver()
+	return sub
+}
+
+// publish queues msg, applying the policy of the subscriber if its queue is
+// full. It returns false if the subscriber should be disconnected.
+func (sub *subscriber) publish(msg string) bool {
+	sub.mu.Lock()
+	defer sub.mu.Unlock()
+
+	atomic.AddUint64(&sub.published, 1)
+	if len(sub.queue) >= sub.size {
+		switch sub.policy {
+		case CoalesceLatest:
+			atomic.AddUint64(&sub.coalesced, 1)
+			sub.queue[len(sub.queue)-1] = msg
+			return true
+		case Disconnect:
+			atomic.AddUint64(&sub.dropped, uint64(len(sub.queue))+1)
+			sub.queue = nil
+			return false
+		default:
+			atomic.AddUint64(&sub.dropped, 1)
+			sub.queue = sub.queue[1:]
+		}
+	}
+	sub.queue = append(sub.queue, msg)
+
+	select {
+	case sub.wake <- struct{}{}:
+	default:
+	}
+	return true
+}
+
+// close stops delivering to the subscriber and closes its channel, after
+// delivering the messages still queued if drain is true.
+func (sub *subscriber) close(drain bool) {
+	sub.mu.Lock()
+	defer sub.mu.Unlock()
+
+	if sub.closed {
+		return
+	}
+	sub.closed = true
+	if !drain {
+		atomic.AddUint64(&sub.dropped, uint64(len(sub.queue)))
+		sub.queue = nil
+		close(sub.done)
+	}
+
+	select {
+	case sub.wake <- struct{}{}:
+	default:
+	}
+}
+
+// next returns the next message to deliver, or false once the subscriber is
+// closed and there is nothing left to deliver.
+func (sub *subscriber) next() (string, bool) {
+	for {
+		sub.mu.Lock()
+		if len(sub.queue) > 0 {
+			msg := sub.queue[0]
+			sub.queue = sub.queue[1:]
+			sub.mu.Unlock()
+			return msg, true
+		}
+		closed := sub.closed
+		sub.mu.Unlock()
+
+		if closed {
+			return "", false
+		}
+		<-sub.wake
+	}
+}
+
+func (sub *subscriber) deliver() {
+	defer close(sub.ch)
+	for {
+		msg, ok := sub.next()
+		if !ok {
+			return
+		}
+
+		select {
+		case sub.ch <- msg:
+			atomic.AddUint64(&sub.delivered, 1)
+		case <-sub.done:
+			atomic.AddUint64(&sub.dropped, 1)
+			return
+		}
+	}
+}
+
+func (sub *subscriber) stats() Stats {
+	return Stats{
+		Published:    atomic.LoadUint64(&sub.published),
+		Delivered:    atomic.LoadUint64(&sub.delivered),
+		Dropped:      atomic.LoadUint64(&sub.dropped),
+		Coalesced:    atomic.LoadUint64(&sub.coalesced),
+		Disconnected: atomic.LoadUint64(&sub.disconnected),
+	}
+}
@@ -0,0 +1,199 @@
+package pubsub
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+)
+
+// waitTaken waits until the subscriber id of topic has taken every queued
+// message to deliver, so that the next message published is queued behind
+// one it is blocked sending.
+func waitTaken(t *testing.T, ps *Pubsub, topic, id string) {
+	t.Helper()
+
+	ps.mu.RLock()
+	sub := ps.subs[topic][id]
+	ps.mu.RUnlock()
+
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		sub.mu.Lock()
+		n := len(sub.queue)
+		sub.mu.Unlock()
+		if n == 0 {
+			return
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("%d messages still queued", n)
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+// receive returns the messages sent on ch until it is closed.
+func receive(t *testing.T, ch chan string) []string {
+	t.Helper()
+
+	var msgs []string
+	timeout := time.After(5 * time.Second)
+	for {
+		select {
+		case msg, ok := <-ch:
+			if !ok {
+				return msgs
+			}
+			msgs = append(msgs, msg)
+		case <-timeout:
+			t.Fatalf("channel not closed, received %v", msgs)
+		}
+	}
+}
+
+func TestPolicies(t *testing.T) {
+	for _, tc := range []struct {
+		name   string
+		opts   Options
+		behind int
+		want   []string
+		stats  Stats
+	}{{
+		name:   "drop oldest",
+		opts:   Options{Policy: DropOldest, QueueSize: 2},
+		behind: 5,
+		want:   []string{"0", "4", "5"},
+		stats:  Stats{Published: 6, Delivered: 3, Dropped: 3},
+	}, {
+		name:   "drop oldest within queue",
+		opts:   Options{Policy: DropOldest, QueueSize: 2},
+		behind: 2,
+		want:   []string{"0", "1", "2"},
+		stats:  Stats{Published: 3, Delivered: 3},
+	}, {
+		name:   "coalesce latest",
+		opts:   Options{Policy: CoalesceLatest, QueueSize: 8},
+		behind: 5,
+		want:   []string{"0", "5"},
+		stats:  Stats{Published: 6, Delivered: 2, Coalesced: 4},
+	}, {
+		name:   "disconnect within queue",
+		opts:   Options{Policy: Disconnect, QueueSize: 2},
+		behind: 2,
+		want:   []string{"0", "1", "2"},
+		stats:  Stats{Published: 3, Delivered: 3},
+	}} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			ps := New()
+			ch := make(chan string)
+			ps.Subscribe("topic", "id", ch, tc.opts)
+
+			// The first message is taken and blocks on the unbuffered
+			// channel, so that the rest are queued behind it.
+			ps.Publish("topic", "0")
+			waitTaken(t, ps, "topic", "id")
+			for i := 1; i <= tc.behind; i++ {
+				ps.Publish("topic", fmt.Sprint(i))
+			}
+
+			ps.Close()
+			got := receive(t, ch)
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("received %v, expected %v", got, tc.want)
+			}
+			if stats := ps.Totals("topic"); stats != tc.stats {
+				t.Errorf("stats %+v, expected %+v", stats, tc.stats)
+			}
+		})
+	}
+}
+
+func TestDisconnect(t *testing.T) {
+	ps := New()
+	ch := make(chan string)
+	ps.Subscribe("topic", "id", ch, Options{Policy: Disconnect, QueueSize: 2})
+
+	ps.Publish("topic", "0")
+	waitTaken(t, ps, "topic", "id")
+	for i := 1; i <= 3; i++ {
+		ps.Publish("topic", fmt.Sprint(i))
+	}
+
+	// The message blocked on the channel may still be delivered, but none
+	// of those queued behind it.
+	got := receive(t, ch)
+	if len(got) > 1 {
+		t.Errorf("received %v after disconnecting", got)
+	}
+	if _, ok := ps.Stats("topic")["id"]; ok {
+		t.Error("disconnected subscriber still listed")
+	}
+
+	stats := ps.Totals("topic")
+	if stats.Disconnected != 1 || stats.Published != 4 || stats.Dropped < 3 {
+		t.Errorf("unexpected stats %+v", stats)
+	}
+
+	// Publishing to a topic without subscribers is fine.
+	ps.Publish("topic", "4")
+	if total := ps.Totals("topic"); total.Published != 4 {
+		t.Errorf("published %d, expected 4", total.Published)
+	}
+}
+
+func TestUnsubscribe(t *testing.T) {
+	ps := New()
+	first := make(chan string, 1)
+	ps.Subscribe("topic", "id", first, Options{})
+	ps.Publish("topic", "a")
+	if msg := <-first; msg != "a" {
+		t.Fatalf("received %q", msg)
+	}
+
+	// Subscribing again with the same id replaces the first subscriber.
+	second := make(chan string, 1)
+	ps.Subscribe("topic", "id", second, Options{})
+	if got := receive(t, first); len(got) != 0 {
+		t.Errorf("replaced subscriber received %v", got)
+	}
+	ps.Publish("topic", "b")
+	if msg := <-second; msg != "b" {
+		t.Fatalf("received %q", msg)
+	}
+
+	ps.Unsubscribe("topic", "id")
+	ps.Unsubscribe("topic", "id")
+	if got := receive(t, second); len(got) != 0 {
+		t.Errorf("unsubscribed subscriber received %v", got)
+	}
+	if len(ps.Stats("topic")) != 0 {
+		t.Errorf("unexpected subscribers %v", ps.Stats("topic"))
+	}
+	if stats := ps.Totals("topic"); stats != (Stats{Published: 2, Delivered: 2}) {
+		t.Errorf("unexpected totals %+v", stats)
+	}
+}
+
+func TestClose(t *testing.T) {
+	ps := New()
+	ch := make(chan string, 4)
+	ps.Subscribe("topic", "id", ch, Options{})
+	ps.Publish("topic", "a")
+
+	ps.Close()
+	ps.Close()
+	ps.Publish("topic", "b")
+	ps.Unsubscribe("topic", "id")
+	if got := receive(t, ch); !reflect.DeepEqual(got, []string{"a"}) {
+		t.Errorf("received %v, expected [a]", got)
+	}
+
+	// Subscribing after closing closes the channel rather than leaving it
+	// open forever.
+	late := make(chan string)
+	ps.Subscribe("topic", "late", late, Options{})
+	if got := receive(t, late); len(got) != 0 {
+		t.Errorf("received %v after closing", got)
+	}
+}
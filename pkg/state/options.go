@@ -0,0 +1,82 @@
+package state
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// Named layouts a window can be arranged by, besides a layout string.
+const (
+	LayoutEvenHorizontal = "even-horizontal"
+	LayoutEvenVertical   = "even-vertical"
+	LayoutMainHorizontal = "main-horizontal"
+	LayoutMainVertical   = "main-vertical"
+	LayoutTiled          = "tiled"
+)
+
+// Layouts is the order in which named layouts are cycled through.
+var Layouts = []string{
+	LayoutEvenHorizontal,
+	LayoutEvenVertical,
+	LayoutMainHorizontal,
+	LayoutMainVertical,
+	LayoutTiled,
+}
+
+// Options that choose which alerts a pane raises.
+const (
+	// OptionMonitorActivity raises an alert when the process writes output.
+	OptionMonitorActivity = "monitor-activity"
+
+	// OptionMonitorBell raises an alert and rings the bell of every peer
+	// when the process rings the bell. It is on by default.
+	OptionMonitorBell = "monitor-bell"
+
+	// OptionMonitorSilence raises an alert when the process has written
+	// nothing for a duration such as "30s", or a number of seconds. A
+	// duration of 0 turns it off.
+	OptionMonitorSilence = "monitor-silence"
+)
+
+// ValidateOption returns an error if value can't be given to the pane option
+// name.
+func ValidateOption(name, value string) error {
+	var err error
+	switch name {
+	case OptionMonitorActivity, OptionMonitorBell:
+		_, err = ParseSwitch(value)
+	case OptionMonitorSilence:
+		_, err = ParseSeconds(value)
+	default:
+		return fmt.Errorf("unknown option %q", name)
+	}
+	if err != nil {
+		return fmt.Errorf("%s: %w", name, err)
+	}
+	return nil
+}
+
+// ParseSwitch parses the value of an option that is on or off.
+func ParseSwitch(value string) (bool, error) {
+	switch value {
+	case "on", "true", "yes", "1":
+		return true, nil
+	case "off", "false", "no", "0":
+		return false, nil
+	}
+	return false, fmt.Errorf("expected on or off, got %q", value)
+}
+
+// ParseSeconds parses the value of an option that is a duration such as
+// "30s", or a number of seconds.
+func ParseSeconds(value string) (time.Duration, error) {
+	if secs, err := strconv.Atoi(value); err == nil && secs >= 0 {
+		return time.Duration(secs) * time.Second, nil
+	}
+	d, err := time.ParseDuration(value)
+	if err != nil || d < 0 {
+		return 0, fmt.Errorf("expected a duration, got %q", value)
+	}
+	return d, nil
+}
@@ -0,0 +1,182 @@
+// Package state defines the on-disk form of a ptmux session, so that its
+// windows, layouts and pane commands can be saved and later restored.
+package state
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+)
+
+// Session is the saved state of a ptmux session.
+type Session struct {
+	Name string `json:"name"`
+
+	// Current is the index of the window the host is viewing.
+	Current int `json:"current,omitempty"`
+
+	Windows []Window `json:"windows"`
+
+	// Hooks are the commands run on each session event. See package hooks.
+	Hooks map[string][]string `json:"hooks,omitempty"`
+}
+
+// Window is the saved state of a window.
+type Window struct {
+	// Layout is either a named layout such as "tiled", or a layout string
+	// describing the columns/pile tree and the weight of each node, e.g.
+	// "{2:p,1:[1:p,1:p]}".
+	Layout string `json:"layout"`
+
+	// Active is the index of the pane the host has focused.
+	Active int `json:"active,omitempty"`
+
+	// Panes are the panes of the window in the order they appear in Layout.
+	Panes []Pane `json:"panes"`
+}
+
+// Pane is the saved state of a pane.
+type Pane struct {
+	// Command is the program and arguments run in the pane. If empty, an
+	// interactive $SHELL is run.
+	Command []string `json:"command,omitempty"`
+
+	// Cwd is the working directory of the pane.
+	Cwd string `json:"cwd,omitempty"`
+
+	// Env is added to the environment of the pane, in the form "key=value".
+	Env []string `json:"env,omitempty"`
+
+	// Title is the title shown in the frame of the pane until the program
+	// sets one.
+	Title string `json:"title,omitempty"`
+
+	// Options are the options of the pane that differ from their defaults,
+	// such as "monitor-activity".
+	Options map[string]string `json:"options,omitempty"`
+}
+
+// Validate checks that the session can be restored.
+func (s *Session) Validate() error {
+	if len(s.Windows) == 0 {
+		return fmt.Errorf("session %q has no windows", s.Name)
+	}
+	if s.Current < 0 || s.Current >= len(s.Windows) {
+		return fmt.Errorf("current window %d is out of range", s.Current)
+	}
+	for i, w := range s.Windows {
+		if len(w.Panes) == 0 {
+			return fmt.Errorf("window %d has no panes", i)
+		}
+		if w.Active < 0 || w.Active >= len(w.Panes) {
+			return fmt.Errorf("window %d: active pane %d is out of range", i, w.Active)
+		}
+	}
+	return nil
+}
+
+// Dir returns the directory sessions are saved in, which is ptmux under
+// $XDG_STATE_HOME, or ~/.local/state/ptmux if it is unset.
+func Dir() (string, error) {
+	if dir := os.Getenv("XDG_STATE_HOME"); dir != "" {
+		return filepath.Join(dir, "ptmux"), nil
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(home, ".local", "state", "ptmux"), nil
+}
+
+// Path returns the path the session name is saved to.
+func Path(name string) (string, error) {
+	if name == "" || filepath.Base(name) != name {
+		return "", fmt.Errorf("invalid session name %q", name)
+	}
+	dir, err := Dir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, name+".json"), nil
+}
+
+// Load reads the saved session name.
+func Load(name string) (*Session, error) {
+	path, err := Path(name)
+	if err != nil {
+		return nil, err
+	}
+
+	dt, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var s Session
+	err = json.Unmarshal(dt, &s)
+	if err != nil {
+		return nil, fmt.Errorf("invalid session file %s: %w", path, err)
+	}
+	if s.Name == "" {
+		s.Name = name
+	}
+
+	err = s.Validate()
+	if err != nil {
+		return nil, fmt.Errorf("invalid session file %s: %w", path, err)
+	}
+	return &s, nil
+}
+
+// Save writes the session to disk, replacing any previously saved session of
+// the same name atomically.
+func Save(s *Session) error {
+	path, err := Path(s.Name)
+	if err != nil {
+		return err
+	}
+
+	err = os.MkdirAll(filepath.Dir(path), 0700)
+	if err != nil {
+		return err
+	}
+
+	dt, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	f, err := ioutil.TempFile(filepath.Dir(path), "."+s.Name+".*.json")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name())
+
+	_, err = f.Write(append(dt, '\n'))
+	if err != nil {
+		f.Close()
+		return err
+	}
+
+	err = f.Close()
+	if err != nil {
+		return err
+	}
+	return os.Rename(f.Name(), path)
+}
+
+// Remove removes the saved session name, if there is one.
+func Remove(name string) error {
+	path, err := Path(name)
+	if err != nil {
+		return err
+	}
+
+	err = os.Remove(path)
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,82 @@
+package state
+
+import (
+	"io/ioutil"
+	"os"
+	"reflect"
+	"testing"
+)
+
+func withStateDir(t *testing.T) {
+	t.Helper()
+
+	dir, err := ioutil.TempDir("", "ptmux-state")
+	if err != nil {
+		t.Fatal(err)
+	}
+	prev, ok := os.LookupEnv("XDG_STATE_HOME")
+	os.Setenv("XDG_STATE_HOME", dir)
+	t.Cleanup(func() {
+		if ok {
+			os.Setenv("XDG_STATE_HOME", prev)
+		} else {
+			os.Unsetenv("XDG_STATE_HOME")
+		}
+		os.RemoveAll(dir)
+	})
+}
+
+func TestSaveLoadRemove(t *testing.T) {
+	withStateDir(t)
+
+	s := &Session{
+		Name: "test",
+		Windows: []Window{{
+			Layout: "{1:p,1:p}",
+			Active: 1,
+			Panes:  []Pane{{Cwd: "/tmp"}, {Command: []string{"top"}}},
+		}},
+	}
+	err := Save(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := Load("test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(got, s) {
+		t.Fatalf("loaded %+v, expected %+v", got, s)
+	}
+
+	for i := 0; i < 2; i++ {
+		err = Remove("test")
+		if err != nil {
+			t.Fatalf("remove %d: %s", i, err)
+		}
+	}
+	_, err = Load("test")
+	if !os.IsNotExist(err) {
+		t.Fatalf("expected the session to be removed, got %v", err)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	pane := []Pane{{}}
+	for _, tc := range []struct {
+		name    string
+		session Session
+		valid   bool
+	}{
+		{"valid", Session{Windows: []Window{{Panes: pane}}}, true},
+		{"no windows", Session{}, false},
+		{"current out of range", Session{Current: 1, Windows: []Window{{Panes: pane}}}, false},
+		{"no panes", Session{Windows: []Window{{}}}, false},
+		{"active out of range", Session{Windows: []Window{{Active: 1, Panes: pane}}}, false},
+	} {
+		err := tc.session.Validate()
+		if (err == nil) != tc.valid {
+			t.Errorf("%s: unexpected result %v", tc.name, err)
+		}
+	}
+}
@@ -0,0 +1,62 @@
+package vt
+
+// bellScanner finds BEL characters in the output of a process. A BEL that
+// ends an OSC, DCS, APC or PM string, such as when setting the window title,
+// isn't a bell, so the scanner follows escape sequences across reads.
+type bellScanner struct {
+	state bellState
+}
+
+type bellState int
+
+const (
+	bellGround bellState = iota
+	bellEscape
+	bellString
+	bellStringEscape
+)
+
+// scan returns true if p rings the bell.
+func (s *bellScanner) scan(p []byte) bool {
+	rang := false
+	for _, b := range p {
+		switch s.state {
+		case bellGround:
+			switch b {
+			case '\a':
+				rang = true
+			case '\033':
+				s.state = bellEscape
+			}
+		case bellEscape:
+			switch b {
+			case ']', 'P', '_', '^':
+				s.state = bellString
+			case '\033':
+			default:
+				s.state = bellGround
+			}
+		case bellString:
+			switch b {
+			case '\a':
+				s.state = bellGround
+			case '\033':
+				s.state = bellStringEscape
+			}
+		case bellStringEscape:
+			// ESC \ ends the string. Any other escape cancels it and starts
+			// a new sequence.
+			switch b {
+			case '\\':
+				s.state = bellGround
+			case ']', 'P', '_', '^':
+				s.state = bellString
+			case '\033':
+				s.state = bellEscape
+			default:
+				s.state = bellGround
+			}
+		}
+	}
+	return rang
+}
@@ -0,0 +1,32 @@
+package vt
+
+import "testing"
+
+func TestBellScanner(t *testing.T) {
+	for _, tc := range []struct {
+		name  string
+		reads []string
+		rang  []bool
+	}{
+		{"bel", []string{"done\a"}, []bool{true}},
+		{"plain", []string{"no bell here\r\n"}, []bool{false}},
+		{"title", []string{"\033]0;vim\a"}, []bool{false}},
+		{"title st", []string{"\033]0;vim\033\\\a"}, []bool{true}},
+		{"title then bel", []string{"\033]2;t\a\a"}, []bool{true}},
+		{"dcs", []string{"\033Pq#0\a"}, []bool{false}},
+		{"apc", []string{"\033_G\a"}, []bool{false}},
+		{"pm", []string{"\033^x\a"}, []bool{false}},
+		{"split title", []string{"\033]0;v", "im\a", "\a"}, []bool{false, false, true}},
+		{"split escape", []string{"\033", "]0;t\a"}, []bool{false, false}},
+		{"cancelled string", []string{"\033]0;t\033[m\a"}, []bool{true}},
+		{"restarted string", []string{"\033]0;t\033]0;u\a"}, []bool{false}},
+		{"csi", []string{"\033[31m\a"}, []bool{true}},
+	} {
+		var s bellScanner
+		for i, r := range tc.reads {
+			if got := s.scan([]byte(r)); got != tc.rang[i] {
+				t.Errorf("%s: read %d rang %t, expected %t", tc.name, i, got, tc.rang[i])
+			}
+		}
+	}
+}
@@ -0,0 +1,121 @@
+package vt
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/hinshun/vt10x"
+)
+
+// Glyph modes, matching the unexported attributes of vt10x.
+const (
+	attrReverse = 1 << iota
+	attrUnderline
+	attrBold
+	attrGfx
+	attrItalic
+	attrBlink
+)
+
+// Capture returns the contents of the screen as text, one line per row, with
+// trailing blank lines removed. If history is true, the lines that scrolled off
+// the top of the screen are included before it. If escapes is true, colors
+// and text attributes are included as SGR escape sequences.
+func (vt *VT) Capture(escapes, history bool) string {
+	var lines [][]vt10x.Glyph
+	if history {
+		lines = vt.history.get()
+	}
+
+	vt.Terminal.Lock()
+	cols, rows := vt.Terminal.Size()
+	for y := 0; y < rows; y++ {
+		line := make([]vt10x.Glyph, cols)
+		for x := range line {
+			line[x] = vt.Terminal.Cell(x, y)
+		}
+		lines = append(lines, line)
+	}
+	vt.Terminal.Unlock()
+
+	text := make([]string, len(lines))
+	for i, line := range lines {
+		text[i] = captureLine(line, escapes)
+	}
+	for len(text) > 0 && text[len(text)-1] == "" {
+		text = text[:len(text)-1]
+	}
+	if len(text) == 0 {
+		return ""
+	}
+	return strings.Join(text, "\n") + "\n"
+}
+
+func captureLine(line []vt10x.Glyph, escapes bool) string {
+	// Trailing blanks are padding rather than output.
+	end := len(line)
+	for end > 0 && isBlank(line[end-1]) {
+		end--
+	}
+
+	var (
+		sb   strings.Builder
+		prev = vt10x.Glyph{FG: vt10x.DefaultFG, BG: vt10x.DefaultBG}
+	)
+	for _, g := range line[:end] {
+		if escapes && (g.Mode != prev.Mode || g.FG != prev.FG || g.BG != prev.BG) {
+			sb.WriteString(sgr(g))
+			prev = g
+		}
+		if g.Char == 0 {
+			g.Char = ' '
+		}
+		sb.WriteRune(g.Char)
+	}
+	if escapes && (prev.Mode != 0 || prev.FG != vt10x.DefaultFG || prev.BG != vt10x.DefaultBG) {
+		sb.WriteString("\x1b[0m")
+	}
+	return sb.String()
+}
+
+func isBlank(g vt10x.Glyph) bool {
+	return (g.Char == ' ' || g.Char == 0) && g.BG == vt10x.DefaultBG && g.Mode&(attrReverse|attrUnderline) == 0
+}
+
+// sgr returns the escape sequence that sets the attributes of g.
+func sgr(g vt10x.Glyph) string {
+	params := []string{"0"}
+	for _, attr := range []struct {
+		mode  int16
+		param string
+	}{
+		{attrBold, "1"},
+		{attrItalic, "3"},
+		{attrUnderline, "4"},
+		{attrBlink, "5"},
+		{attrReverse, "7"},
+	} {
+		if g.Mode&attr.mode != 0 {
+			params = append(params, attr.param)
+		}
+	}
+	params = append(params, colorParams(g.FG, 30, vt10x.DefaultFG)...)
+	params = append(params, colorParams(g.BG, 40, vt10x.DefaultBG)...)
+	return "\x1b[" + strings.Join(params, ";") + "m"
+}
+
+func colorParams(c vt10x.Color, base int, def vt10x.Color) []string {
+	switch {
+	case c == def:
+		return nil
+	case c < 8:
+		return []string{strconv.Itoa(base + int(c))}
+	case c < 16:
+		return []string{strconv.Itoa(base + 60 + int(c) - 8)}
+	case c < 256:
+		return []string{strconv.Itoa(base + 8), "5", strconv.Itoa(int(c))}
+	case c < 1<<24:
+		return []string{strconv.Itoa(base + 8), "2", strconv.Itoa(int(c >> 16 & 0xff)), strconv.Itoa(int(c >> 8 & 0xff)), strconv.Itoa(int(c & 0xff))}
+	}
+	return nil
+}
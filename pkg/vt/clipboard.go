@@ -0,0 +1,114 @@
+package vt
+
+import (
+	"bytes"
+	"encoding/base64"
+)
+
+// maxClipboard is the longest OSC 52 string kept, so that a process can't
+// grow the scanner without bound. Longer strings are ignored.
+const maxClipboard = 1 << 20
+
+// clipboardScanner finds OSC 52 sequences, which set the clipboard, in the
+// output of a process. Sequences are followed across reads, and queries of
+// the clipboard are ignored so that processes can never read it.
+type clipboardScanner struct {
+	state    bellState
+	osc      bool
+	buf      []byte
+	overflow bool
+}
+
+// scan returns the data the last OSC 52 sequence in p sets the clipboard to,
+// or false if p sets none.
+func (s *clipboardScanner) scan(p []byte) ([]byte, bool) {
+	var (
+		data []byte
+		ok   bool
+	)
+	for _, b := range p {
+		switch s.state {
+		case bellGround:
+			if b == '\033' {
+				s.state = bellEscape
+			}
+		case bellEscape:
+			switch b {
+			case ']', 'P', '_', '^':
+				s.start(b == ']')
+			case '\033':
+			default:
+				s.state = bellGround
+			}
+		case bellString:
+			switch b {
+			case '\a':
+				s.state = bellGround
+				if d, set := s.end(); set {
+					data, ok = d, true
+				}
+			case '\033':
+				s.state = bellStringEscape
+			default:
+				s.write(b)
+			}
+		case bellStringEscape:
+			switch b {
+			case '\\':
+				s.state = bellGround
+				if d, set := s.end(); set {
+					data, ok = d, true
+				}
+			case ']', 'P', '_', '^':
+				s.start(b == ']')
+			case '\033':
+				s.state = bellEscape
+			default:
+				s.state = bellGround
+			}
+		}
+	}
+	return data, ok
+}
+
+func (s *clipboardScanner) start(osc bool) {
+	s.state = bellString
+	s.osc = osc
+	s.buf = s.buf[:0]
+	s.overflow = false
+}
+
+func (s *clipboardScanner) write(b byte) {
+	if !s.osc || s.overflow {
+		return
+	}
+	if len(s.buf) >= maxClipboard {
+		s.overflow = true
+		return
+	}
+	s.buf = append(s.buf, b)
+}
+
+// end parses the OSC string that just ended, returning the data it sets the
+// clipboard to if it is a valid OSC 52 sequence.
+func (s *clipboardScanner) end() ([]byte, bool) {
+	if !s.osc || s.overflow || !bytes.HasPrefix(s.buf, []byte("52;")) {
+		return nil, false
+	}
+
+	// The parameters are the selections to set, then the data in base64.
+	params := s.buf[len("52;"):]
+	i := bytes.IndexByte(params, ';')
+	if i < 0 {
+		return nil, false
+	}
+	encoded := params[i+1:]
+	if bytes.Equal(encoded, []byte("?")) {
+		return nil, false
+	}
+	data, err := base64.StdEncoding.DecodeString(string(encoded))
+	if err != nil {
+		return nil, false
+	}
+	return data, true
+}
@@ -0,0 +1,56 @@
+package vt
+
+import (
+	"testing"
+)
+
+func TestClipboardScanner(t *testing.T) {
+	for _, tc := range []struct {
+		name  string
+		reads []string
+		data  string
+		ok    bool
+	}{
+		{"bel", []string{"\033]52;c;aGVsbG8=\a"}, "hello", true},
+		{"st", []string{"\033]52;c;aGVsbG8=\033\\"}, "hello", true},
+		{"primary", []string{"\033]52;p;aGk=\a"}, "hi", true},
+		{"empty", []string{"\033]52;c;\a"}, "", true},
+		{"split", []string{"out\033]5", "2;c;aGV", "sbG8=\033", "\\more"}, "hello", true},
+		{"last wins", []string{"\033]52;c;YQ==\a\033]52;c;Yg==\a"}, "b", true},
+		{"query", []string{"\033]52;c;?\a"}, "", false},
+		{"invalid base64", []string{"\033]52;c;!!\a"}, "", false},
+		{"no selection", []string{"\033]52;aGk=\a"}, "", false},
+		{"title", []string{"\033]0;52;c;aGk=\a"}, "", false},
+		{"dcs", []string{"\033P52;c;aGk=\033\\"}, "", false},
+		{"cancelled", []string{"\033]52;c;aGk=\033[m\a"}, "", false},
+		{"plain", []string{"52;c;aGk=\a"}, "", false},
+	} {
+		var (
+			s    clipboardScanner
+			data []byte
+			ok   bool
+		)
+		for _, r := range tc.reads {
+			if d, set := s.scan([]byte(r)); set {
+				data, ok = d, true
+			}
+		}
+		if ok != tc.ok || string(data) != tc.data {
+			t.Errorf("%s: got %q, %t, expected %q, %t", tc.name, data, ok, tc.data, tc.ok)
+		}
+	}
+}
+
+func TestClipboardScannerOverflow(t *testing.T) {
+	var s clipboardScanner
+	long := make([]byte, maxClipboard+8)
+	for i := range long {
+		long[i] = 'A'
+	}
+	if _, ok := s.scan(append(append([]byte("\033]52;c;"), long...), '\a')); ok {
+		t.Fatal("expected a string longer than maxClipboard to be ignored")
+	}
+	if data, ok := s.scan([]byte("\033]52;c;aGk=\a")); !ok || string(data) != "hi" {
+		t.Fatalf("got %q, %t after overflowing", data, ok)
+	}
+}
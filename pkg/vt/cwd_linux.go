@@ -0,0 +1,11 @@
+package vt
+
+import (
+	"fmt"
+	"os"
+)
+
+// processCwd returns the working directory of the process pid.
+func processCwd(pid int) (string, error) {
+	return os.Readlink(fmt.Sprintf("/proc/%d/cwd", pid))
+}
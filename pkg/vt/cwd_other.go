@@ -0,0 +1,30 @@
+//go:build !linux
+// +build !linux
+
+package vt
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"os/exec"
+	"strconv"
+)
+
+// processCwd returns the working directory of the process pid. Without /proc,
+// such as on macOS, it is asked of lsof.
+func processCwd(pid int) (string, error) {
+	out, err := exec.Command("lsof", "-a", "-p", strconv.Itoa(pid), "-d", "cwd", "-Fn").Output()
+	if err != nil {
+		return "", fmt.Errorf("lsof: %w", err)
+	}
+
+	// Each field is on a line of its own, prefixed by its name.
+	s := bufio.NewScanner(bytes.NewReader(out))
+	for s.Scan() {
+		if line := s.Text(); len(line) > 1 && line[0] == 'n' {
+			return line[1:], nil
+		}
+	}
+	return "", fmt.Errorf("no working directory for process %d", pid)
+}
@@ -0,0 +1,60 @@
+package vt
+
+import (
+	"github.com/hinshun/vt10x"
+	"golang.org/x/sys/unix"
+)
+
+// HidesInput returns true if the process is reading input the terminal doesn't
+// echo, as password prompts do. Line editors such as readline, which turn off
+// echo to draw the line themselves, are not hiding input. It returns false if
+// there is no process or the terminal settings can't be read.
+func (vt *VT) HidesInput() bool {
+	lflag, ok := vt.lflag()
+	return ok && hidesInput(lflag)
+}
+
+// LineEditing returns true if the process appears to be editing a line of
+// input, echoing printable keys where the cursor is. Either the terminal
+// echoes input, or a line editor such as readline does, having turned off
+// canonical mode along with echo. Password prompts, which turn off echo
+// alone, and full-screen programs on the alternate screen are not.
+func (vt *VT) LineEditing() bool {
+	if !vt.CursorVisible() || vt.Mode()&vt10x.ModeAltScreen != 0 {
+		return false
+	}
+	lflag, ok := vt.lflag()
+	return ok && !hidesInput(lflag)
+}
+
+// hidesInput returns true if the local modes lflag turn off echo while
+// leaving the terminal in canonical mode, so that what is typed is read a
+// line at a time and never shown.
+func hidesInput(lflag uint32) bool {
+	return lflag&unix.ECHO == 0 && lflag&unix.ICANON != 0
+}
+
+// lflag returns the local modes of the terminal of the process, or false if
+// there is no process or they can't be read.
+func (vt *VT) lflag() (uint32, bool) {
+	if vt.ptm == nil {
+		return 0, false
+	}
+
+	conn, err := vt.ptm.SyscallConn()
+	if err != nil {
+		return 0, false
+	}
+
+	var (
+		lflag uint32
+		ok    bool
+	)
+	conn.Control(func(fd uintptr) {
+		termios, err := unix.IoctlGetTermios(int(fd), ioctlReadTermios)
+		if err == nil {
+			lflag, ok = uint32(termios.Lflag), true
+		}
+	})
+	return lflag, ok
+}
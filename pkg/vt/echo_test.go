@@ -0,0 +1,62 @@
+package vt
+
+import (
+	"testing"
+	"time"
+
+	"golang.org/x/sys/unix"
+)
+
+func TestHidesInput(t *testing.T) {
+	for _, tc := range []struct {
+		name  string
+		lflag uint32
+		hides bool
+	}{
+		{"cooked", unix.ECHO | unix.ICANON, false},
+		{"readline", 0, false},
+		{"raw with echo", unix.ECHO, false},
+		{"password prompt", unix.ICANON, true},
+	} {
+		if got := hidesInput(tc.lflag); got != tc.hides {
+			t.Errorf("%s: expected %t, got %t", tc.name, tc.hides, got)
+		}
+	}
+}
+
+// TestHidesInputPane checks the terminal settings of real processes, as a
+// shell line editor and a password prompt set them.
+func TestHidesInputPane(t *testing.T) {
+	for _, tc := range []struct {
+		name  string
+		stty  string
+		hides bool
+	}{
+		{"readline", "-echo -icanon", false},
+		{"password prompt", "-echo icanon", true},
+	} {
+		vt, err := New(80, 24, Command{Args: []string{"/bin/sh", "-c", "stty " + tc.stty + " && sleep 10"}})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// Both turn off echo, once stty has run.
+		deadline := time.Now().Add(5 * time.Second)
+		for {
+			lflag, ok := vt.lflag()
+			if ok && lflag&unix.ECHO == 0 {
+				break
+			}
+			if time.Now().After(deadline) {
+				t.Fatalf("%s: echo never turned off", tc.name)
+			}
+			time.Sleep(10 * time.Millisecond)
+		}
+
+		if got := vt.HidesInput(); got != tc.hides {
+			t.Errorf("%s: expected %t, got %t", tc.name, tc.hides, got)
+		}
+		vt.Kill()
+		<-vt.Done()
+	}
+}
@@ -0,0 +1,61 @@
+package vt
+
+import (
+	"fmt"
+	"os/exec"
+	"syscall"
+
+	"golang.org/x/sys/unix"
+)
+
+// ExitStatus describes how the process running in a VT terminated.
+type ExitStatus struct {
+	Code   int
+	Signal syscall.Signal
+}
+
+func exitStatusFromError(err error) ExitStatus {
+	if err == nil {
+		return ExitStatus{}
+	}
+
+	exitErr, ok := err.(*exec.ExitError)
+	if !ok {
+		return ExitStatus{Code: -1}
+	}
+
+	ws, ok := exitErr.Sys().(syscall.WaitStatus)
+	if ok && ws.Signaled() {
+		return ExitStatus{Code: -1, Signal: ws.Signal()}
+	}
+	return ExitStatus{Code: exitErr.ExitCode()}
+}
+
+// Signaled returns true if the process was terminated by a signal.
+func (es ExitStatus) Signaled() bool {
+	return es.Signal != 0
+}
+
+// Success returns true if the process exited with a zero exit code.
+func (es ExitStatus) Success() bool {
+	return es.Code == 0 && !es.Signaled()
+}
+
+// SignalName returns the name of the terminating signal, e.g. SIGSEGV.
+func (es ExitStatus) SignalName() string {
+	if !es.Signaled() {
+		return ""
+	}
+	name := unix.SignalName(es.Signal)
+	if name == "" {
+		name = fmt.Sprintf("signal %d", int(es.Signal))
+	}
+	return name
+}
+
+func (es ExitStatus) String() string {
+	if es.Signaled() {
+		return fmt.Sprintf("killed by %s", es.SignalName())
+	}
+	return fmt.Sprintf("exited %d", es.Code)
+}
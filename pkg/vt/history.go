@@ -0,0 +1,63 @@
+package vt
+
+import (
+	"sync"
+
+	"github.com/hinshun/vt10x"
+)
+
+// HistoryLimit is the number of lines that scrolled off the top of the screen
+// kept for each VT.
+var HistoryLimit = 2000
+
+// history is a ring buffer of lines that scrolled off the top of the screen.
+type history struct {
+	mu    sync.Mutex
+	lines [][]vt10x.Glyph
+	start int
+}
+
+func (h *history) push(line []vt10x.Glyph) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if len(h.lines) < HistoryLimit {
+		h.lines = append(h.lines, line)
+		return
+	}
+	if len(h.lines) == 0 {
+		return
+	}
+	h.lines[h.start] = line
+	h.start = (h.start + 1) % len(h.lines)
+}
+
+// get returns the lines from oldest to newest.
+func (h *history) get() [][]vt10x.Glyph {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	lines := make([][]vt10x.Glyph, 0, len(h.lines))
+	lines = append(lines, h.lines[h.start:]...)
+	return append(lines, h.lines[:h.start]...)
+}
+
+// saveScrolledLine saves the top line of the screen to the history if a line
+// feed would scroll it off. Lines are not saved from the alternate screen,
+// which full screen programs redraw as a whole.
+func (vt *VT) saveScrolledLine() {
+	vt.Terminal.Lock()
+	cols, rows := vt.Terminal.Size()
+	if vt.Terminal.Cursor().Y != rows-1 || vt.Terminal.Mode()&vt10x.ModeAltScreen != 0 {
+		vt.Terminal.Unlock()
+		return
+	}
+
+	line := make([]vt10x.Glyph, cols)
+	for x := range line {
+		line[x] = vt.Terminal.Cell(x, 0)
+	}
+	vt.Terminal.Unlock()
+
+	vt.history.push(line)
+}
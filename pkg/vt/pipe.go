@@ -0,0 +1,131 @@
+package vt
+
+import (
+	"io"
+	"sync"
+	"sync/atomic"
+)
+
+// pipeQueue is how many reads of output may be waiting to be written to a
+// pipe before further output is dropped.
+const pipeQueue = 256
+
+// pipe copies the output of the process to a writer in the background, so
+// that a slow writer never holds up parsing.
+type pipe struct {
+	w       io.WriteCloser
+	strip   *stripper
+	ch      chan []byte
+	dropped int64
+}
+
+func newPipe(w io.WriteCloser, strip bool) *pipe {
+	p := &pipe{
+		w:  w,
+		ch: make(chan []byte, pipeQueue),
+	}
+	if strip {
+		p.strip = &stripper{}
+	}
+
+	go func() {
+		defer p.w.Close()
+		for data := range p.ch {
+			if p.strip != nil {
+				data = p.strip.strip(data)
+			}
+			if len(data) == 0 {
+				continue
+			}
+			_, err := p.w.Write(data)
+			if err != nil {
+				// The queue fills up and further output is dropped until
+				// the pipe is replaced or stopped.
+				return
+			}
+		}
+	}()
+	return p
+}
+
+// write queues a copy of data, or drops it if the queue is full.
+func (p *pipe) write(data []byte) {
+	select {
+	case p.ch <- append([]byte(nil), data...):
+	default:
+		atomic.AddInt64(&p.dropped, int64(len(data)))
+	}
+}
+
+// close closes the writer once the output still queued has been written.
+func (p *pipe) close() {
+	close(p.ch)
+}
+
+// pipes holds the pipe of a VT, guarded separately from the terminal so
+// starting or stopping it never waits on parsing.
+type pipes struct {
+	mu     sync.Mutex
+	pipe   *pipe
+	closed bool
+}
+
+func (ps *pipes) write(data []byte) {
+	ps.mu.Lock()
+	defer ps.mu.Unlock()
+	if ps.pipe != nil {
+		ps.pipe.write(data)
+	}
+}
+
+// set replaces the pipe with p and closes the previous one. Once the process
+// has exited, p is closed straight away.
+func (ps *pipes) set(p *pipe) {
+	ps.mu.Lock()
+	defer ps.mu.Unlock()
+
+	if ps.pipe != nil {
+		ps.pipe.close()
+	}
+	ps.pipe = p
+	if ps.closed && p != nil {
+		p.close()
+		ps.pipe = nil
+	}
+}
+
+// close closes the pipe once the process has exited.
+func (ps *pipes) close() {
+	ps.mu.Lock()
+	defer ps.mu.Unlock()
+
+	ps.closed = true
+	if ps.pipe != nil {
+		ps.pipe.close()
+		ps.pipe = nil
+	}
+}
+
+// Pipe writes everything the process writes to w from now on, replacing any
+// previous pipe, which is closed. If strip is true, escape sequences are
+// removed so that only the text remains. Output is queued for w without
+// waiting, and dropped if w falls too far behind. A nil w stops piping, and w
+// is closed when the process exits.
+func (vt *VT) Pipe(w io.WriteCloser, strip bool) {
+	var p *pipe
+	if w != nil {
+		p = newPipe(w, strip)
+	}
+	vt.pipes.set(p)
+}
+
+// PipeDropped returns the number of bytes of output dropped because the
+// current pipe fell behind.
+func (vt *VT) PipeDropped() int64 {
+	vt.pipes.mu.Lock()
+	defer vt.pipes.mu.Unlock()
+	if vt.pipes.pipe == nil {
+		return 0
+	}
+	return atomic.LoadInt64(&vt.pipes.pipe.dropped)
+}
@@ -0,0 +1,73 @@
+package vt
+
+// stripper removes escape sequences and control characters from the output
+// of a process, leaving its text and newlines. Sequences are followed across
+// reads.
+type stripper struct {
+	state stripState
+}
+
+type stripState int
+
+const (
+	stripGround stripState = iota
+	stripEscape
+	stripCSI
+	stripString
+	stripStringEscape
+)
+
+// strip returns p without escape sequences. It modifies p in place.
+func (s *stripper) strip(p []byte) []byte {
+	out := p[:0]
+	for _, b := range p {
+		switch s.state {
+		case stripGround:
+			switch {
+			case b == '\033':
+				s.state = stripEscape
+			case b == '\n' || b == '\t' || (b >= ' ' && b != 0x7f):
+				out = append(out, b)
+			}
+		case stripEscape:
+			switch {
+			case b == '[':
+				s.state = stripCSI
+			case b == ']' || b == 'P' || b == '_' || b == '^' || b == 'X':
+				s.state = stripString
+			case b >= 0x20 && b <= 0x2f:
+				// Intermediate bytes, such as in ESC ( B.
+			case b == '\033':
+			default:
+				s.state = stripGround
+			}
+		case stripCSI:
+			if b >= 0x40 && b <= 0x7e {
+				s.state = stripGround
+			}
+		case stripString:
+			switch b {
+			case '\a':
+				s.state = stripGround
+			case '\033':
+				s.state = stripStringEscape
+			}
+		case stripStringEscape:
+			// ESC \ ends the string. Any other escape cancels it and starts
+			// a new sequence.
+			switch b {
+			case '\\':
+				s.state = stripGround
+			case '[':
+				s.state = stripCSI
+			case ']', 'P', '_', '^', 'X':
+				s.state = stripString
+			case '\033':
+				s.state = stripEscape
+			default:
+				s.state = stripGround
+			}
+		}
+	}
+	return out
+}
@@ -0,0 +1,39 @@
+package vt
+
+import "testing"
+
+func TestStripper(t *testing.T) {
+	for _, tc := range []struct {
+		name  string
+		reads []string
+		want  string
+	}{
+		{"plain", []string{"hello\n"}, "hello\n"},
+		{"tabs", []string{"a\tb\r\n"}, "a\tb\n"},
+		{"controls", []string{"a\a\b\x7fb\x00"}, "ab"},
+		{"sgr", []string{"\033[1;31merror\033[0m\n"}, "error\n"},
+		{"private csi", []string{"\033[?1049h\033[?25lx"}, "x"},
+		{"charset", []string{"\033(Bx\033)0y"}, "xy"},
+		{"keypad", []string{"\033=x\033>"}, "x"},
+		{"title", []string{"\033]0;vim\ax"}, "x"},
+		{"title st", []string{"\033]2;vim\033\\x"}, "x"},
+		{"dcs", []string{"\033Pq#0;2;0;0;0\033\\x"}, "x"},
+		{"sos", []string{"\033Xignored\033\\x"}, "x"},
+		{"split csi", []string{"a\033[", "31", "mb"}, "ab"},
+		{"split string", []string{"\033]0;", "title\033", "\\b"}, "b"},
+		{"cancelled string", []string{"\033]0;t\033[mx"}, "x"},
+		{"double escape", []string{"\033\033[mx"}, "x"},
+		{"utf-8", []string{"caf\xc3", "\xa9\n"}, "café\n"},
+	} {
+		var (
+			s   stripper
+			got string
+		)
+		for _, r := range tc.reads {
+			got += string(s.strip([]byte(r)))
+		}
+		if got != tc.want {
+			t.Errorf("%s: expected %q, got %q", tc.name, tc.want, got)
+		}
+	}
+}
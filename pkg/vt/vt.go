@@ -1,9 +1,15 @@
 package vt
 
 import (
-	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
 	"os"
 	"os/exec"
+	"sync"
+	"syscall"
+	"unicode/utf8"
 
 	"github.com/creack/pty"
 	"github.com/hinshun/ptmux/pkg/pubsub"
@@ -11,18 +17,58 @@ import (
 )
 
 const (
-	updateTopic = "update"
+	updateTopic    = "update"
+	outputTopic    = "output"
+	bellTopic      = "bell"
+	clipboardTopic = "clipboard"
 )
 
+// outputQueue is how many reads of output may be waiting for a subscriber to
+// SubscribeOutput before the oldest are dropped.
+const outputQueue = 1024
+
 type VT struct {
 	vt10x.Terminal
+	cmd    *exec.Cmd
 	ptm    *os.File
 	pubsub *pubsub.Pubsub
 	done   chan struct{}
+
+	history   history
+	bells     bellScanner
+	clipboard clipboardScanner
+	pipes     pipes
+
+	mu         sync.Mutex
+	exitStatus ExitStatus
+}
+
+// Command describes the process started in a VT.
+type Command struct {
+	// Args is the program and its arguments. If empty, an interactive $SHELL
+	// is started.
+	Args []string
+
+	// Dir is the working directory of the process. If empty or if it no longer
+	// exists, the process inherits the working directory of ptmux.
+	Dir string
+
+	// Env is added to the environment of ptmux, in the form "key=value".
+	Env []string
 }
 
-func New(cols, rows int) (*VT, error) {
-	cmd := exec.Command(os.Getenv("SHELL"), "-i")
+func New(cols, rows int, command Command) (*VT, error) {
+	args := command.Args
+	if len(args) == 0 {
+		args = []string{os.Getenv("SHELL"), "-i"}
+	}
+	cmd := exec.Command(args[0], args[1:]...)
+	if fi, err := os.Stat(command.Dir); err == nil && fi.IsDir() {
+		cmd.Dir = command.Dir
+	}
+	if len(command.Env) > 0 {
+		cmd.Env = append(os.Environ(), command.Env...)
+	}
 	ptm, err := pty.StartWithSize(cmd, &pty.Winsize{
 		Cols: uint16(cols),
 		Rows: uint16(rows),
@@ -31,46 +77,193 @@ func New(cols, rows int) (*VT, error) {
 		return nil, err
 	}
 
-	vt := vt10x.New(vt10x.WithWriter(ptm), vt10x.WithSize(cols, rows))
-	ps := pubsub.New()
-	done := make(chan struct{})
-	go func() {
-		defer close(done)
-		defer ps.Close()
-
-		br := bufio.NewReader(ptm)
-		for {
-			err := vt.Parse(br)
-			if err != nil {
-				// log.Error().Err(err).Msg("unable to parse virtual terminal")
-				break
-			}
+	vt := &VT{
+		Terminal: vt10x.New(vt10x.WithWriter(ptm), vt10x.WithSize(cols, rows)),
+		cmd:      cmd,
+		ptm:      ptm,
+		pubsub:   pubsub.New(),
+		done:     make(chan struct{}),
+	}
+
+	go vt.read(ptm)
+
+	return vt, nil
+}
+
+// Replay returns a VT that displays what is read from r, such as a recording
+// being played back, instead of the output of a process. Input written to it
+// is discarded. Done is closed once r returns an error, including io.EOF.
+func Replay(cols, rows int, r io.Reader) *VT {
+	vt := &VT{
+		Terminal: vt10x.New(vt10x.WithWriter(ioutil.Discard), vt10x.WithSize(cols, rows)),
+		pubsub:   pubsub.New(),
+		done:     make(chan struct{}),
+	}
+	go vt.read(r)
+	return vt
+}
 
-			ps.Publish(updateTopic, "")
+// read feeds the output read from r to the terminal until r returns an
+// error, then reaps the process if there is one.
+func (vt *VT) read(r io.Reader) {
+	defer close(vt.done)
+	defer vt.pubsub.Close()
+	defer vt.pipes.close()
+
+	buf := make([]byte, 32*1024)
+	var pending []byte
+	for {
+		n, err := r.Read(buf)
+		if err != nil {
+			// log.Error().Err(err).Msg("unable to read pty")
+			break
 		}
-	}()
 
-	return &VT{
-		Terminal: vt,
-		ptm:      ptm,
-		pubsub:   ps,
-		done:     done,
-	}, nil
+		vt.pipes.write(buf[:n])
+		pending = vt.parse(append(pending, buf[:n]...))
+		vt.pubsub.Publish(updateTopic, "")
+		vt.pubsub.Publish(outputTopic, string(buf[:n]))
+		if vt.bells.scan(buf[:n]) {
+			vt.pubsub.Publish(bellTopic, "")
+		}
+		if data, ok := vt.clipboard.scan(buf[:n]); ok {
+			vt.pubsub.Publish(clipboardTopic, string(data))
+		}
+	}
+
+	if vt.cmd == nil {
+		return
+	}
+
+	// Reap the child so it doesn't linger as a zombie, and keep its exit
+	// status around for the UI.
+	err := vt.cmd.Wait()
+	vt.mu.Lock()
+	vt.exitStatus = exitStatusFromError(err)
+	vt.mu.Unlock()
+
+	vt.ptm.Close()
+}
+
+// parse feeds output of the process to the terminal a line at a time, so that
+// lines about to scroll off the top of the screen can be saved to the history.
+// It returns the bytes of a trailing incomplete UTF-8 sequence, which must be
+// passed to the next call.
+func (vt *VT) parse(p []byte) []byte {
+	n := incompleteRune(p)
+	pending := append([]byte(nil), p[len(p)-n:]...)
+	p = p[:len(p)-n]
+
+	for len(p) > 0 {
+		i := bytes.IndexByte(p, '\n')
+		if i < 0 {
+			vt.Terminal.Write(p)
+			break
+		}
+
+		vt.Terminal.Write(p[:i])
+		vt.saveScrolledLine()
+		vt.Terminal.Write(p[i : i+1])
+		p = p[i+1:]
+	}
+	return pending
+}
+
+// incompleteRune returns the length of an incomplete UTF-8 sequence at the end
+// of p.
+func incompleteRune(p []byte) int {
+	for i := 1; i <= utf8.UTFMax && i <= len(p); i++ {
+		if utf8.RuneStart(p[len(p)-i]) {
+			if utf8.FullRune(p[len(p)-i:]) {
+				return 0
+			}
+			return i
+		}
+	}
+	return 0
 }
 
 func (vt *VT) Write(p []byte) (n int, err error) {
+	if vt.ptm == nil {
+		return len(p), nil
+	}
 	return vt.ptm.Write(p)
 }
 
+// Done returns a channel that is closed after the process has exited and has
+// been reaped.
 func (vt *VT) Done() <-chan struct{} {
 	return vt.done
 }
 
+// ExitStatus returns the exit status of the process. It is only valid after
+// Done has been closed.
+func (vt *VT) ExitStatus() ExitStatus {
+	vt.mu.Lock()
+	defer vt.mu.Unlock()
+	return vt.exitStatus
+}
+
+// Kill sends SIGHUP to the process group of the process, as if its
+// controlling terminal was hung up.
+func (vt *VT) Kill() error {
+	select {
+	case <-vt.done:
+		return nil
+	default:
+	}
+	if vt.cmd == nil {
+		return nil
+	}
+	return syscall.Kill(-vt.cmd.Process.Pid, syscall.SIGHUP)
+}
+
+// Cwd returns the current working directory of the process, read from /proc
+// on Linux and from lsof elsewhere.
+func (vt *VT) Cwd() (string, error) {
+	if vt.cmd == nil {
+		return "", fmt.Errorf("no process")
+	}
+	return processCwd(vt.cmd.Process.Pid)
+}
+
 func (vt *VT) Resize(cols, rows int) {
-	vt10x.ResizePty(vt.ptm, cols, rows)
+	if vt.ptm != nil {
+		vt10x.ResizePty(vt.ptm, cols, rows)
+	}
 	vt.Terminal.Resize(cols, rows)
 }
 
+// Subscribe sends on ch each time the process writes output and the terminal
+// has been updated. ch is closed when the process exits.
 func (vt *VT) Subscribe(id string, ch chan string) {
-	vt.pubsub.Subscribe(updateTopic, id, ch)
+	vt.pubsub.Subscribe(updateTopic, id, ch, pubsub.Options{Policy: pubsub.CoalesceLatest})
+}
+
+// SubscribeBell sends on ch each time the process rings the bell.
+func (vt *VT) SubscribeBell(id string, ch chan string) {
+	vt.pubsub.Subscribe(bellTopic, id, ch, pubsub.Options{Policy: pubsub.CoalesceLatest})
+}
+
+// SubscribeClipboard sends on ch what the process sets the clipboard to with
+// OSC 52.
+func (vt *VT) SubscribeClipboard(id string, ch chan string) {
+	vt.pubsub.Subscribe(clipboardTopic, id, ch, pubsub.Options{Policy: pubsub.CoalesceLatest})
+}
+
+// UnsubscribeOutput stops sending output to the channel subscribed as id and
+// closes it.
+func (vt *VT) UnsubscribeOutput(id string) {
+	vt.pubsub.Unsubscribe(outputTopic, id)
+}
+
+// SubscribeOutput sends everything the process writes to ch, as it is read
+// from the pty. ch is closed when the process exits. If the subscriber falls
+// too far behind, the oldest output is dropped rather than holding up the
+// terminal.
+func (vt *VT) SubscribeOutput(id string, ch chan string) {
+	vt.pubsub.Subscribe(outputTopic, id, ch, pubsub.Options{
+		Policy:    pubsub.DropOldest,
+		QueueSize: outputQueue,
+	})
 }
@@ -0,0 +1,500 @@
+// Package workspace reads declarative session templates, which describe the
+// windows of a session, how each window is split into panes, and the command
+// run in each pane.
+//
+// A workspace is written in YAML:
+//
+//	name: api
+//	root: ~/src/api
+//	env:
+//	  GOFLAGS: -mod=mod
+//	hooks:
+//	  peer-join: notify-send "$PTMUX_PEER joined $PTMUX_SESSION"
+//	windows:
+//	  - split: vertical
+//	    panes:
+//	      - command: nvim
+//	        size: 2
+//	      - split: horizontal
+//	        panes:
+//	          - command: go run ./cmd/server
+//	            env:
+//	              PORT: "8080"
+//	          - command: tail -f server.log
+//	  - layout: tiled
+//	    options:
+//	      monitor-activity: on
+//	    panes:
+//	      - command: go test ./...
+//	      - command: psql
+//
+// A vertical split places its panes side by side and a horizontal split
+// stacks them, with each pane taking space in proportion to its size. A window
+// may instead use a named layout for a flat list of panes. Options such as
+// monitor-activity apply to every pane below the node they are set on. Hooks
+// map an event to a command or a list of commands, as described in package
+// hooks.
+package workspace
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hinshun/ptmux/pkg/hooks"
+	"github.com/hinshun/ptmux/pkg/state"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	SplitVertical   = "vertical"
+	SplitHorizontal = "horizontal"
+)
+
+// Error is a validation error at a position in a workspace file.
+type Error struct {
+	Path   string
+	Line   int
+	Column int
+	Msg    string
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("%s:%d:%d: %s", e.Path, e.Line, e.Column, e.Msg)
+}
+
+// Load reads the workspace file at path and returns the session it describes.
+func Load(path string) (*state.Session, error) {
+	dt, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var doc yaml.Node
+	err = yaml.Unmarshal(dt, &doc)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	if len(doc.Content) == 0 {
+		return nil, fmt.Errorf("%s: empty workspace", path)
+	}
+
+	d := &decoder{path: path}
+	ws, err := d.workspace(doc.Content[0])
+	if err != nil {
+		return nil, err
+	}
+
+	if ws.name == "" {
+		ws.name = strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))
+	}
+
+	dir, err := filepath.Abs(filepath.Dir(path))
+	if err != nil {
+		return nil, err
+	}
+	root, err := d.resolveRoot(ws.rootNode, dir, ws.root)
+	if err != nil {
+		return nil, err
+	}
+
+	st := &state.Session{
+		Name:  ws.name,
+		Hooks: ws.hooks,
+	}
+	for _, win := range ws.windows {
+		layout, panes, err := d.tree(win, root, ws.env, nil)
+		if err != nil {
+			return nil, err
+		}
+		st.Windows = append(st.Windows, state.Window{
+			Layout: layout,
+			Panes:  panes,
+		})
+	}
+	return st, nil
+}
+
+type workspace struct {
+	name     string
+	root     string
+	rootNode *yaml.Node
+	env      map[string]string
+	hooks    map[string][]string
+	windows  []*node
+}
+
+// node is either a pane running a command, or a split of other nodes.
+type node struct {
+	split    string
+	layout   string
+	size     int
+	command  string
+	title    string
+	root     string
+	rootNode *yaml.Node
+	env      map[string]string
+	options  map[string]string
+	panes    []*node
+}
+
+type decoder struct {
+	path string
+}
+
+func (d *decoder) errorf(n *yaml.Node, format string, a ...interface{}) error {
+	return &Error{
+		Path:   d.path,
+		Line:   n.Line,
+		Column: n.Column,
+		Msg:    fmt.Sprintf(format, a...),
+	}
+}
+
+func (d *decoder) workspace(n *yaml.Node) (*workspace, error) {
+	ws := &workspace{}
+	var windows *yaml.Node
+	err := d.mapping(n, map[string]func(*yaml.Node) error{
+		"name":  d.str(&ws.name),
+		"root":  d.root(&ws.root, &ws.rootNode),
+		"env":   d.env(&ws.env),
+		"hooks": d.hooks(&ws.hooks),
+		"windows": func(v *yaml.Node) error {
+			windows = v
+			return nil
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if windows == nil {
+		return nil, d.errorf(n, "workspace has no windows")
+	}
+	if windows.Kind != yaml.SequenceNode || len(windows.Content) == 0 {
+		return nil, d.errorf(windows, "windows must be a list of at least one window")
+	}
+	for _, v := range windows.Content {
+		win, err := d.node(v, true)
+		if err != nil {
+			return nil, err
+		}
+		ws.windows = append(ws.windows, win)
+	}
+	return ws, nil
+}
+
+func (d *decoder) node(n *yaml.Node, isWindow bool) (*node, error) {
+	nd := &node{size: 1}
+	var panes *yaml.Node
+	fields := map[string]func(*yaml.Node) error{
+		"split":   d.enum(&nd.split, SplitVertical, SplitHorizontal),
+		"command": d.str(&nd.command),
+		"title":   d.str(&nd.title),
+		"root":    d.root(&nd.root, &nd.rootNode),
+		"env":     d.env(&nd.env),
+		"options": d.options(&nd.options),
+		"panes": func(v *yaml.Node) error {
+			panes = v
+			return nil
+		},
+	}
+	if isWindow {
+		fields["layout"] = d.enum(&nd.layout, state.Layouts...)
+	} else {
+		fields["size"] = d.positive(&nd.size)
+	}
+
+	err := d.mapping(n, fields)
+	if err != nil {
+		return nil, err
+	}
+
+	if panes == nil {
+		if nd.split != "" || nd.layout != "" {
+			return nil, d.errorf(n, "split and layout need a list of panes")
+		}
+		return nd, nil
+	}
+
+	if nd.command != "" || nd.title != "" {
+		return nil, d.errorf(n, "a pane with a command or title can't also have panes")
+	}
+	if panes.Kind != yaml.SequenceNode || len(panes.Content) == 0 {
+		return nil, d.errorf(panes, "panes must be a list of at least one pane")
+	}
+
+	switch {
+	case nd.layout != "" && nd.split != "":
+		return nil, d.errorf(n, "a window can't have both a split and a layout")
+	case nd.layout == "" && nd.split == "":
+		nd.split = SplitVertical
+	}
+
+	for _, v := range panes.Content {
+		child, err := d.node(v, false)
+		if err != nil {
+			return nil, err
+		}
+		if nd.layout != "" && child.panes != nil {
+			return nil, d.errorf(v, "panes of a window with a layout can't be split")
+		}
+		nd.panes = append(nd.panes, child)
+	}
+	return nd, nil
+}
+
+// mapping decodes a mapping node by calling the field of each key, rejecting
+// unknown and duplicate keys.
+func (d *decoder) mapping(n *yaml.Node, fields map[string]func(*yaml.Node) error) error {
+	if n.Kind != yaml.MappingNode {
+		return d.errorf(n, "expected a mapping")
+	}
+
+	seen := make(map[string]bool)
+	for i := 0; i+1 < len(n.Content); i += 2 {
+		k, v := n.Content[i], n.Content[i+1]
+		field, ok := fields[k.Value]
+		if !ok {
+			return d.errorf(k, "unknown field %q", k.Value)
+		}
+		if seen[k.Value] {
+			return d.errorf(k, "duplicate field %q", k.Value)
+		}
+		seen[k.Value] = true
+
+		err := field(v)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (d *decoder) str(s *string) func(*yaml.Node) error {
+	return func(n *yaml.Node) error {
+		if n.Kind != yaml.ScalarNode {
+			return d.errorf(n, "expected a string")
+		}
+		*s = n.Value
+		return nil
+	}
+}
+
+func (d *decoder) enum(s *string, values ...string) func(*yaml.Node) error {
+	return func(n *yaml.Node) error {
+		for _, v := range values {
+			if n.Kind == yaml.ScalarNode && n.Value == v {
+				*s = v
+				return nil
+			}
+		}
+		return d.errorf(n, "expected one of %s", strings.Join(values, ", "))
+	}
+}
+
+func (d *decoder) positive(i *int) func(*yaml.Node) error {
+	return func(n *yaml.Node) error {
+		v, err := strconv.Atoi(n.Value)
+		if n.Kind != yaml.ScalarNode || err != nil || v < 1 {
+			return d.errorf(n, "expected a positive integer")
+		}
+		*i = v
+		return nil
+	}
+}
+
+func (d *decoder) env(env *map[string]string) func(*yaml.Node) error {
+	return func(n *yaml.Node) error {
+		if n.Kind != yaml.MappingNode {
+			return d.errorf(n, "expected a mapping of environment variables")
+		}
+
+		*env = make(map[string]string)
+		for i := 0; i+1 < len(n.Content); i += 2 {
+			k, v := n.Content[i], n.Content[i+1]
+			if k.Kind != yaml.ScalarNode || k.Value == "" || strings.ContainsRune(k.Value, '=') {
+				return d.errorf(k, "invalid environment variable name %q", k.Value)
+			}
+			if v.Kind != yaml.ScalarNode {
+				return d.errorf(v, "expected a string")
+			}
+			(*env)[k.Value] = v.Value
+		}
+		return nil
+	}
+}
+
+func (d *decoder) options(options *map[string]string) func(*yaml.Node) error {
+	return func(n *yaml.Node) error {
+		if n.Kind != yaml.MappingNode {
+			return d.errorf(n, "expected a mapping of options")
+		}
+
+		*options = make(map[string]string)
+		for i := 0; i+1 < len(n.Content); i += 2 {
+			k, v := n.Content[i], n.Content[i+1]
+			if v.Kind != yaml.ScalarNode {
+				return d.errorf(v, "expected a value")
+			}
+			err := state.ValidateOption(k.Value, v.Value)
+			if err != nil {
+				return d.errorf(k, "%s", err)
+			}
+			(*options)[k.Value] = v.Value
+		}
+		return nil
+	}
+}
+
+func (d *decoder) hooks(commands *map[string][]string) func(*yaml.Node) error {
+	return func(n *yaml.Node) error {
+		if n.Kind != yaml.MappingNode {
+			return d.errorf(n, "expected a mapping of events to commands")
+		}
+
+		*commands = make(map[string][]string)
+		for i := 0; i+1 < len(n.Content); i += 2 {
+			k, v := n.Content[i], n.Content[i+1]
+			if k.Kind != yaml.ScalarNode || !hooks.Valid(k.Value) {
+				return d.errorf(k, "unknown hook event %q", k.Value)
+			}
+			if _, ok := (*commands)[k.Value]; ok {
+				return d.errorf(k, "duplicate hook event %q", k.Value)
+			}
+
+			switch v.Kind {
+			case yaml.ScalarNode:
+				(*commands)[k.Value] = []string{v.Value}
+			case yaml.SequenceNode:
+				for _, c := range v.Content {
+					if c.Kind != yaml.ScalarNode {
+						return d.errorf(c, "expected a command")
+					}
+					(*commands)[k.Value] = append((*commands)[k.Value], c.Value)
+				}
+			default:
+				return d.errorf(v, "expected a command or a list of commands")
+			}
+		}
+		return nil
+	}
+}
+
+func (d *decoder) root(s *string, rootNode **yaml.Node) func(*yaml.Node) error {
+	return func(n *yaml.Node) error {
+		*rootNode = n
+		return d.str(s)(n)
+	}
+}
+
+// resolveRoot resolves root relative to the root of the parent, which is
+// already absolute.
+func (d *decoder) resolveRoot(n *yaml.Node, parent, root string) (string, error) {
+	if root == "" {
+		return parent, nil
+	}
+
+	if root == "~" || strings.HasPrefix(root, "~/") {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return "", d.errorf(n, "%s", err)
+		}
+		root = filepath.Join(home, root[1:])
+	}
+	if !filepath.IsAbs(root) {
+		root = filepath.Join(parent, root)
+	}
+
+	fi, err := os.Stat(root)
+	if err != nil || !fi.IsDir() {
+		return "", d.errorf(n, "root %q is not a directory", root)
+	}
+	return root, nil
+}
+
+// tree converts the node into a layout string and the panes in it, in the
+// order they appear in the layout.
+func (d *decoder) tree(nd *node, root string, env, options map[string]string) (string, []state.Pane, error) {
+	root, err := d.resolveRoot(nd.rootNode, root, nd.root)
+	if err != nil {
+		return "", nil, err
+	}
+	env = merge(env, nd.env)
+	options = merge(options, nd.options)
+
+	if nd.panes == nil {
+		return "p", []state.Pane{newPane(nd, root, env, options)}, nil
+	}
+
+	var (
+		layouts  []string
+		children []string
+		panes    []state.Pane
+	)
+	for _, child := range nd.panes {
+		layout, childPanes, err := d.tree(child, root, env, options)
+		if err != nil {
+			return "", nil, err
+		}
+		layouts = append(layouts, layout)
+		children = append(children, fmt.Sprintf("%d:%s", child.size, layout))
+		panes = append(panes, childPanes...)
+	}
+
+	switch {
+	case nd.layout != "":
+		return nd.layout, panes, nil
+	case len(layouts) == 1:
+		// A split of one pane is just that pane.
+		return layouts[0], panes, nil
+	case nd.split == SplitVertical:
+		return "{" + strings.Join(children, ",") + "}", panes, nil
+	default:
+		return "[" + strings.Join(children, ",") + "]", panes, nil
+	}
+}
+
+func newPane(nd *node, root string, env, options map[string]string) state.Pane {
+	p := state.Pane{
+		Cwd:     root,
+		Title:   nd.title,
+		Options: options,
+	}
+	if nd.command != "" {
+		shell := os.Getenv("SHELL")
+		if shell == "" {
+			shell = "/bin/sh"
+		}
+		p.Command = []string{shell, "-c", nd.command}
+	}
+
+	keys := make([]string, 0, len(env))
+	for k := range env {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		p.Env = append(p.Env, k+"="+env[k])
+	}
+	return p
+}
+
+// merge returns the entries of parent overridden by those of m.
+func merge(parent, m map[string]string) map[string]string {
+	if len(m) == 0 {
+		return parent
+	}
+	merged := make(map[string]string)
+	for k, v := range parent {
+		merged[k] = v
+	}
+	for k, v := range m {
+		merged[k] = v
+	}
+	return merged
+}
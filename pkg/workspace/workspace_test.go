@@ -0,0 +1,191 @@
+package workspace
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hinshun/ptmux/pkg/state"
+)
+
+// writeWorkspace writes a workspace file with the lines given and returns its
+// path.
+func writeWorkspace(t *testing.T, lines ...string) string {
+	t.Helper()
+
+	dir, err := ioutil.TempDir("", "ptmux-workspace")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { os.RemoveAll(dir) })
+
+	path := filepath.Join(dir, "api.yaml")
+	err = ioutil.WriteFile(path, []byte(strings.Join(lines, "\n")+"\n"), 0600)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestLoad(t *testing.T) {
+	shell, ok := os.LookupEnv("SHELL")
+	os.Setenv("SHELL", "/bin/sh")
+	t.Cleanup(func() {
+		if ok {
+			os.Setenv("SHELL", shell)
+		} else {
+			os.Unsetenv("SHELL")
+		}
+	})
+
+	path := writeWorkspace(t,
+		"env:",
+		"  GOFLAGS: -mod=mod",
+		"windows:",
+		"  - split: vertical",
+		"    panes:",
+		"      - command: nvim",
+		"        size: 2",
+		"      - split: horizontal",
+		"        options:",
+		"          monitor-silence: 30s",
+		"        panes:",
+		"          - command: server",
+		"            env:",
+		"              PORT: \"8080\"",
+		"          - title: logs",
+		"  - layout: tiled",
+		"    panes:",
+		"      - command: psql",
+	)
+	dir := filepath.Dir(path)
+
+	st, err := Load(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if st.Name != "api" {
+		t.Errorf("name %q, expected the file name", st.Name)
+	}
+
+	want := []state.Window{{
+		Layout: "{2:p,1:[1:p,1:p]}",
+		Panes: []state.Pane{{
+			Command: []string{"/bin/sh", "-c", "nvim"},
+			Cwd:     dir,
+			Env:     []string{"GOFLAGS=-mod=mod"},
+		}, {
+			Command: []string{"/bin/sh", "-c", "server"},
+			Cwd:     dir,
+			Env:     []string{"GOFLAGS=-mod=mod", "PORT=8080"},
+			Options: map[string]string{state.OptionMonitorSilence: "30s"},
+		}, {
+			Cwd:     dir,
+			Env:     []string{"GOFLAGS=-mod=mod"},
+			Title:   "logs",
+			Options: map[string]string{state.OptionMonitorSilence: "30s"},
+		}},
+	}, {
+		Layout: state.LayoutTiled,
+		Panes: []state.Pane{{
+			Command: []string{"/bin/sh", "-c", "psql"},
+			Cwd:     dir,
+			Env:     []string{"GOFLAGS=-mod=mod"},
+		}},
+	}}
+	if !reflect.DeepEqual(st.Windows, want) {
+		t.Errorf("got windows %+v, expected %+v", st.Windows, want)
+	}
+}
+
+func TestLoadErrors(t *testing.T) {
+	for _, tc := range []struct {
+		name   string
+		lines  []string
+		line   int
+		column int
+		msg    string
+	}{{
+		name:   "no windows",
+		lines:  []string{"name: api"},
+		line:   1,
+		column: 1,
+		msg:    "workspace has no windows",
+	}, {
+		name:   "unknown field",
+		lines:  []string{"windows:", "  - panes:", "      - comand: nvim"},
+		line:   3,
+		column: 9,
+		msg:    `unknown field "comand"`,
+	}, {
+		name:   "duplicate field",
+		lines:  []string{"windows:", "  - command: a", "    command: b"},
+		line:   3,
+		column: 5,
+		msg:    `duplicate field "command"`,
+	}, {
+		name:   "unknown layout",
+		lines:  []string{"windows:", "  - layout: spiral", "    panes:", "      - command: a"},
+		line:   2,
+		column: 13,
+		msg:    "expected one of " + strings.Join(state.Layouts, ", "),
+	}, {
+		name:   "bad size",
+		lines:  []string{"windows:", "  - panes:", "      - command: a", "        size: 0"},
+		line:   4,
+		column: 15,
+		msg:    "expected a positive integer",
+	}, {
+		name:   "unknown option",
+		lines:  []string{"windows:", "  - options:", "      monitor-everything: on", "    command: a"},
+		line:   3,
+		column: 7,
+		msg:    `unknown option "monitor-everything"`,
+	}, {
+		name:   "bad option value",
+		lines:  []string{"windows:", "  - options:", "      monitor-silence: soon", "    command: a"},
+		line:   3,
+		column: 7,
+		msg:    `monitor-silence: expected a duration, got "soon"`,
+	}, {
+		name:   "unknown hook",
+		lines:  []string{"hooks:", "  pane-explode: echo", "windows:", "  - command: a"},
+		line:   2,
+		column: 3,
+		msg:    `unknown hook event "pane-explode"`,
+	}, {
+		name:   "split without panes",
+		lines:  []string{"windows:", "  - split: vertical"},
+		line:   2,
+		column: 5,
+		msg:    "split and layout need a list of panes",
+	}, {
+		name:   "split layout panes",
+		lines:  []string{"windows:", "  - layout: tiled", "    panes:", "      - panes:", "          - command: a"},
+		line:   4,
+		column: 9,
+		msg:    "panes of a window with a layout can't be split",
+	}, {
+		name:   "missing root",
+		lines:  []string{"root: ./missing", "windows:", "  - command: a"},
+		line:   1,
+		column: 7,
+		msg:    "is not a directory",
+	}} {
+		path := writeWorkspace(t, tc.lines...)
+		_, err := Load(path)
+
+		var werr *Error
+		if !errors.As(err, &werr) {
+			t.Errorf("%s: expected a workspace error, got %v", tc.name, err)
+			continue
+		}
+		if werr.Path != path || werr.Line != tc.line || werr.Column != tc.column || !strings.Contains(werr.Msg, tc.msg) {
+			t.Errorf("%s: got %q, expected %d:%d: %s", tc.name, err, tc.line, tc.column, tc.msg)
+		}
+	}
+}
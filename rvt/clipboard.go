@@ -0,0 +1,19 @@
+package rvt
+
+import (
+	"encoding/base64"
+	"io"
+)
+
+// Clipboard is implemented by screens that can set the clipboard of the
+// terminal they are shown on.
+type Clipboard interface {
+	SetClipboard(data []byte)
+}
+
+// WriteClipboard sets the clipboard of the terminal w writes to with OSC 52,
+// in a single write so that it isn't interleaved with other output.
+func WriteClipboard(w io.Writer, data []byte) error {
+	_, err := io.WriteString(w, "\033]52;c;"+base64.StdEncoding.EncodeToString(data)+"\a")
+	return err
+}
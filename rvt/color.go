@@ -0,0 +1,148 @@
+package rvt
+
+import (
+	"math"
+	"sync"
+
+	tcell "github.com/gdamore/tcell/v2"
+)
+
+// Color depths a peer's terminal may have, as given by Colors in its
+// InitMessage. Zero means the depth is unknown and colors are sent as they
+// are.
+const (
+	ColorsMono      = 2
+	Colors8         = 8
+	Colors16        = 16
+	Colors256       = 256
+	ColorsTrueColor = 1 << 24
+)
+
+// Downsampler maps colors to the nearest a terminal with fewer colors can
+// show, by their perceived distance. Colors the terminal can show already are
+// kept as they are, and monochrome terminals are sent only the default
+// colors, keeping attributes such as reverse so that selections still show.
+type Downsampler struct {
+	colors int
+	cache  map[tcell.Color]tcell.Color
+}
+
+// NewDownsampler returns a Downsampler to colors, or nil if colors are to be
+// sent as they are.
+func NewDownsampler(colors int) *Downsampler {
+	if colors <= 0 || colors >= ColorsTrueColor {
+		return nil
+	}
+	return &Downsampler{
+		colors: colors,
+		cache:  make(map[tcell.Color]tcell.Color),
+	}
+}
+
+// Styles returns styles with their colors downsampled. The styles are copied
+// rather than modified, since frames may be shared between peers.
+func (d *Downsampler) Styles(styles []*Style) []*Style {
+	if d == nil {
+		return styles
+	}
+
+	downsampled := make([]*Style, len(styles))
+	for i, st := range styles {
+		downsampled[i] = &Style{
+			Fg:       uint64(d.Color(tcell.Color(st.Fg))),
+			Bg:       uint64(d.Color(tcell.Color(st.Bg))),
+			AttrMask: st.AttrMask,
+		}
+	}
+	return downsampled
+}
+
+// Color returns the nearest color to c the terminal can show.
+func (d *Downsampler) Color(c tcell.Color) tcell.Color {
+	if d == nil || !c.Valid() {
+		return c
+	}
+	if d.colors <= ColorsMono {
+		return tcell.ColorDefault
+	}
+
+	if !c.IsRGB() {
+		i := int(c - tcell.ColorValid)
+		switch {
+		case i < d.colors:
+			return c
+		case i < 16 && d.colors >= Colors8:
+			// The bright colors map onto their normal counterparts.
+			return tcell.PaletteColor(i - 8)
+		}
+	}
+
+	if nc, ok := d.cache[c]; ok {
+		return nc
+	}
+	nc := nearestColor(c, d.colors)
+	d.cache[c] = nc
+	return nc
+}
+
+// nearestColor returns the palette color of the first n colors nearest to c.
+func nearestColor(c tcell.Color, n int) tcell.Color {
+	if n > Colors256 {
+		n = Colors256
+	}
+
+	paletteOnce.Do(func() {
+		for i := range paletteLab {
+			paletteLab[i] = lab(tcell.PaletteColor(i))
+		}
+	})
+
+	c1 := lab(c)
+	nearest, min := tcell.ColorDefault, math.Inf(1)
+	for i, c2 := range paletteLab[:n] {
+		var dist float64
+		for j := range c1 {
+			dist += (c1[j] - c2[j]) * (c1[j] - c2[j])
+		}
+		if dist < min {
+			nearest, min = tcell.PaletteColor(i), dist
+		}
+	}
+	return nearest
+}
+
+// paletteLab holds the 256 palette colors in the CIELAB color space.
+var (
+	paletteOnce sync.Once
+	paletteLab  [Colors256][3]float64
+)
+
+// lab returns c in the CIELAB color space, where euclidean distances between
+// colors are close to how different they look.
+func lab(c tcell.Color) [3]float64 {
+	r, g, b := c.RGB()
+
+	// sRGB to linear RGB to CIE XYZ, relative to the D65 white point.
+	lr, lg, lb := linear(r), linear(g), linear(b)
+	x := (0.4124*lr + 0.3576*lg + 0.1805*lb) / 0.95047
+	y := 0.2126*lr + 0.7152*lg + 0.0722*lb
+	z := (0.0193*lr + 0.1192*lg + 0.9505*lb) / 1.08883
+
+	fx, fy, fz := labf(x), labf(y), labf(z)
+	return [3]float64{116*fy - 16, 500 * (fx - fy), 200 * (fy - fz)}
+}
+
+func linear(v int32) float64 {
+	c := float64(v) / 255
+	if c <= 0.04045 {
+		return c / 12.92
+	}
+	return math.Pow((c+0.055)/1.055, 2.4)
+}
+
+func labf(t float64) float64 {
+	if t > 216.0/24389 {
+		return math.Cbrt(t)
+	}
+	return (24389.0/27*t + 16) / 116
+}
@@ -0,0 +1,62 @@
+package rvt
+
+import (
+	"reflect"
+	"testing"
+
+	tcell "github.com/gdamore/tcell/v2"
+)
+
+func TestDownsampler(t *testing.T) {
+	for _, tc := range []struct {
+		colors int
+		in     tcell.Color
+		want   tcell.Color
+	}{
+		{0, tcell.NewRGBColor(1, 2, 3), tcell.NewRGBColor(1, 2, 3)},
+		{ColorsTrueColor, tcell.PaletteColor(200), tcell.PaletteColor(200)},
+		{ColorsMono, tcell.ColorRed, tcell.ColorDefault},
+		{ColorsMono, tcell.NewRGBColor(255, 255, 255), tcell.ColorDefault},
+		{Colors8, tcell.ColorDefault, tcell.ColorDefault},
+		{Colors8, tcell.ColorMaroon, tcell.ColorMaroon},
+		{Colors8, tcell.ColorRed, tcell.ColorMaroon},
+		{Colors8, tcell.ColorWhite, tcell.ColorSilver},
+		{Colors16, tcell.ColorRed, tcell.ColorRed},
+		{Colors16, tcell.PaletteColor(196), tcell.ColorRed},
+		{Colors16, tcell.NewRGBColor(0, 0, 255), tcell.ColorBlue},
+		{Colors256, tcell.PaletteColor(200), tcell.PaletteColor(200)},
+		{Colors256, tcell.NewRGBColor(0x5f, 0x87, 0xaf), tcell.PaletteColor(67)},
+		{Colors256, tcell.NewRGBColor(255, 0, 0), tcell.ColorRed},
+	} {
+		d := NewDownsampler(tc.colors)
+		// Twice, to check the cached color too.
+		for i := 0; i < 2; i++ {
+			if got := d.Color(tc.in); got != tc.want {
+				t.Errorf("%v to %d colors: expected %v, got %v", tc.in, tc.colors, tc.want, got)
+			}
+		}
+	}
+}
+
+func TestDownsamplerStyles(t *testing.T) {
+	styles := []*Style{
+		{Fg: uint64(tcell.ColorRed), Bg: uint64(tcell.ColorDefault), AttrMask: int32(tcell.AttrReverse)},
+	}
+	orig := []*Style{{Fg: styles[0].Fg, Bg: styles[0].Bg, AttrMask: styles[0].AttrMask}}
+
+	got := NewDownsampler(ColorsMono).Styles(styles)
+	want := []*Style{
+		{Fg: uint64(tcell.ColorDefault), Bg: uint64(tcell.ColorDefault), AttrMask: int32(tcell.AttrReverse)},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("expected %v, got %v", want, got)
+	}
+	if !reflect.DeepEqual(styles, orig) {
+		t.Errorf("styles modified to %v", styles)
+	}
+
+	var d *Downsampler
+	if got := d.Styles(styles); &got[0] != &styles[0] {
+		t.Error("nil downsampler copied styles")
+	}
+}
@@ -0,0 +1,299 @@
+package rvt
+
+import (
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"math/rand"
+	"strings"
+	"testing"
+	"time"
+
+	tcell "github.com/gdamore/tcell/v2"
+)
+
+// workload draws frame n of a screen onto s.
+type workload struct {
+	name string
+	draw func(s tcell.Screen, rng *rand.Rand, n int)
+}
+
+var workloads = []workload{
+	{"vim", drawVim},
+	{"htop", drawHtop},
+	{"scrolling-log", drawLog},
+}
+
+func newSimulationScreen(tb testing.TB, cols, rows int) tcell.SimulationScreen {
+	s := tcell.NewSimulationScreen("UTF-8")
+	err := s.Init()
+	if err != nil {
+		tb.Fatal(err)
+	}
+	s.SetSize(cols, rows)
+	tb.Cleanup(s.Fini)
+	return s
+}
+
+// BenchmarkRenderMessage measures encoding frames of typical workloads as
+// runs, and reports the bytes sent per frame with and without gzip, as gRPC
+// compresses each message.
+func BenchmarkRenderMessage(b *testing.B) {
+	for _, wl := range workloads {
+		wl := wl
+		b.Run(wl.name, func(b *testing.B) {
+			s := newSimulationScreen(b, 120, 40)
+			rng := rand.New(rand.NewSource(1))
+
+			var size, compressed int
+			b.ReportAllocs()
+			b.ResetTimer()
+			for n := 0; n < b.N; n++ {
+				b.StopTimer()
+				wl.draw(s, rng, n)
+				s.Show()
+				b.StartTimer()
+
+				dt, err := ScreenToRender(s).Marshal()
+				if err != nil {
+					b.Fatal(err)
+				}
+
+				b.StopTimer()
+				size += len(dt)
+				compressed += len(gzipped(dt))
+				b.StartTimer()
+			}
+			b.ReportMetric(float64(size)/float64(b.N), "B/frame")
+			b.ReportMetric(float64(compressed)/float64(b.N), "gzip-B/frame")
+		})
+	}
+}
+
+// TestRenderRoundTrip checks that frames encoded as runs and a style table
+// decode to the same glyphs as encoding each cell, both expanded for peers
+// without FeatureRuns and drawn onto a screen.
+func TestRenderRoundTrip(t *testing.T) {
+	combining := workload{"combining", func(s tcell.Screen, rng *rand.Rand, n int) {
+		s.Clear()
+		x := drawText(s, 0, 0, "caf", plain)
+		s.SetContent(x, 0, 'e', []rune{'\u0301'}, plain)
+		drawText(s, x+1, 0, " ok", keyword)
+	}}
+
+	for _, wl := range append(workloads, combining) {
+		s := newSimulationScreen(t, 40, 12)
+		drawn := newSimulationScreen(t, 40, 12)
+		rng := rand.New(rand.NewSource(1))
+		for n := 0; n < 20; n++ {
+			wl.draw(s, rng, n)
+			s.Show()
+
+			dt, err := ScreenToRender(s).Marshal()
+			if err != nil {
+				t.Fatal(err)
+			}
+			var msg RenderMessage
+			err = msg.Unmarshal(dt)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			want := glyphRender(s)
+			for _, g := range want.Glyphs {
+				g.Width = 0
+			}
+			got := RunsToGlyphs(&msg)
+			if !got.Equal(want) {
+				t.Fatalf("%s frame %d: runs expanded to different glyphs", wl.name, n)
+			}
+
+			DrawRender(&msg, drawn)
+			drawn.Show()
+			if got := glyphRender(drawn); !got.Equal(glyphRender(s)) {
+				t.Fatalf("%s frame %d: runs drawn differently", wl.name, n)
+			}
+		}
+	}
+}
+
+// glyphRender encodes s as hosts did before runs, with one glyph per cell.
+func glyphRender(s tcell.Screen) *RenderMessage {
+	cols, rows := s.Size()
+	msg := &RenderMessage{Cols: int32(cols), Rows: int32(rows)}
+	for y := 0; y < rows; y++ {
+		for x := 0; x < cols; x++ {
+			mainc, combc, style, width := s.GetContent(x, y)
+			fg, bg, attr := style.Decompose()
+
+			var combi []int32
+			for _, c := range combc {
+				combi = append(combi, int32(c))
+			}
+			msg.Glyphs = append(msg.Glyphs, &Glyph{
+				X:        int32(x),
+				Y:        int32(y),
+				Mainc:    int32(mainc),
+				Combc:    combi,
+				Fg:       uint64(fg),
+				Bg:       uint64(bg),
+				AttrMask: int32(attr),
+				Width:    int32(width),
+			})
+		}
+	}
+	return msg
+}
+
+// gzipped compresses dt on its own, as gRPC compresses each message.
+func gzipped(dt []byte) []byte {
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	zw.Write(dt)
+	zw.Close()
+	return buf.Bytes()
+}
+
+func drawText(s tcell.Screen, x, y int, text string, style tcell.Style) int {
+	cols, _ := s.Size()
+	for _, r := range text {
+		if x >= cols {
+			break
+		}
+		s.SetContent(x, y, r, nil, style)
+		x++
+	}
+	return x
+}
+
+func clearRow(s tcell.Screen, x, y int, style tcell.Style) {
+	cols, _ := s.Size()
+	for ; x < cols; x++ {
+		s.SetContent(x, y, ' ', nil, style)
+	}
+}
+
+var (
+	plain      = tcell.StyleDefault
+	keyword    = plain.Foreground(tcell.ColorYellow).Bold(true)
+	str        = plain.Foreground(tcell.ColorGreen)
+	comment    = plain.Foreground(tcell.ColorBlue)
+	lineNr     = plain.Foreground(tcell.ColorGray)
+	statusLine = plain.Reverse(true)
+)
+
+var code = []string{
+	"package main",
+	"",
+	"import (",
+	"\t\"fmt\"",
+	"\t\"os\"",
+	")",
+	"",
+	"// main greets whoever is named on the command line.",
+	"func main() {",
+	"\tfor i, arg := range os.Args[1:] {",
+	"\t\tif i > 0 {",
+	"\t\t\tfmt.Print(\", \")",
+	"\t\t}",
+	"\t\tfmt.Printf(\"hello %s\", arg)",
+	"\t}",
+	"\tfmt.Println()",
+	"}",
+}
+
+// drawVim draws source code with syntax highlighting and line numbers, with
+// the cursor moving down a line each frame and a character typed every few.
+func drawVim(s tcell.Screen, rng *rand.Rand, n int) {
+	_, rows := s.Size()
+	cursor := n % (rows - 1)
+	for y := 0; y < rows-1; y++ {
+		x := drawText(s, 0, y, fmt.Sprintf("%3d ", y+1), lineNr)
+		line := strings.Replace(code[y%len(code)], "\t", "    ", -1)
+		if y == cursor && n%3 == 0 {
+			line += string(rune('a' + rng.Intn(26)))
+		}
+		for _, word := range strings.SplitAfter(line, " ") {
+			style := plain
+			trimmed := strings.TrimSpace(word)
+			switch {
+			case strings.HasPrefix(trimmed, "//"):
+				style = comment
+			case strings.HasPrefix(trimmed, "\""):
+				style = str
+			case trimmed == "func" || trimmed == "for" || trimmed == "if" || trimmed == "import" || trimmed == "package" || trimmed == "range":
+				style = keyword
+			}
+			x = drawText(s, x, y, word, style)
+		}
+		clearRow(s, x, y, plain)
+	}
+	x := drawText(s, 0, rows-1, fmt.Sprintf(" main.go  %d,%d  All", cursor+1, 5), statusLine)
+	clearRow(s, x, rows-1, statusLine)
+}
+
+// drawHtop draws CPU meters and a table of processes, which are updated and
+// reordered each frame.
+func drawHtop(s tcell.Screen, rng *rand.Rand, n int) {
+	cols, rows := s.Size()
+	for cpu := 0; cpu < 4; cpu++ {
+		x := drawText(s, 0, cpu, fmt.Sprintf("%3d[", cpu), plain)
+		width := cols - 12
+		used := rng.Intn(width)
+		for i := 0; i < width; i++ {
+			style, r := plain, ' '
+			if i < used {
+				r = '|'
+				style = plain.Foreground(tcell.ColorGreen)
+				if i > width/2 {
+					style = plain.Foreground(tcell.ColorRed)
+				}
+			}
+			s.SetContent(x+i, cpu, r, nil, style)
+		}
+		drawText(s, x+width, cpu, fmt.Sprintf("%5.1f%%]", float64(used)*100/float64(width)), plain)
+	}
+
+	header := plain.Background(tcell.ColorGreen).Foreground(tcell.ColorBlack)
+	x := drawText(s, 0, 5, "  PID USER      PRI  NI  VIRT   RES  S CPU% MEM%   TIME+  Command", header)
+	clearRow(s, x, 5, header)
+	for y := 6; y < rows; y++ {
+		style := plain
+		if y == 6+n%(rows-6) {
+			style = plain.Background(tcell.ColorTeal).Foreground(tcell.ColorBlack)
+		}
+		pid := 1000 + rng.Intn(9000)
+		x := drawText(s, 0, y, fmt.Sprintf("%5d ", pid), style)
+		x = drawText(s, x, y, "root      ", style.Foreground(tcell.ColorPurple))
+		x = drawText(s, x, y, fmt.Sprintf(" 20   0 %5dM %4dM S %4.1f %4.1f %2d:%02d.%02d ", rng.Intn(9999), rng.Intn(999), rng.Float64()*100, rng.Float64()*10, rng.Intn(60), rng.Intn(60), rng.Intn(100)), style)
+		x = drawText(s, x, y, "/usr/bin/worker --serve", style.Foreground(tcell.ColorAqua))
+		clearRow(s, x, y, style)
+	}
+}
+
+var levels = []struct {
+	name  string
+	style tcell.Style
+}{
+	{"INFO", plain.Foreground(tcell.ColorGreen)},
+	{"WARN", plain.Foreground(tcell.ColorYellow)},
+	{"ERROR", plain.Foreground(tcell.ColorRed).Bold(true)},
+	{"DEBUG", plain.Foreground(tcell.ColorGray)},
+}
+
+// drawLog draws a log scrolling by a few lines each frame.
+func drawLog(s tcell.Screen, rng *rand.Rand, n int) {
+	_, rows := s.Size()
+	start := time.Date(2022, 1, 27, 10, 4, 5, 0, time.UTC)
+	for y := 0; y < rows; y++ {
+		line := n*3 + y
+		lr := rand.New(rand.NewSource(int64(line)))
+		level := levels[lr.Intn(len(levels))]
+
+		ts := start.Add(time.Duration(line) * 37 * time.Millisecond).Format("2006-01-02T15:04:05.000Z")
+		x := drawText(s, 0, y, ts+" ", lineNr)
+		x = drawText(s, x, y, fmt.Sprintf("%-5s ", level.name), level.style)
+		x = drawText(s, x, y, fmt.Sprintf("request id=%08x method=GET path=/api/v1/items/%d status=200 duration=%dms", lr.Uint32(), lr.Intn(100000), lr.Intn(500)), plain)
+		clearRow(s, x, y, plain)
+	}
+}
@@ -0,0 +1,12 @@
+package rvt
+
+import "fmt"
+
+// ExitStatusString formats an ExitMessage the same way the host formats the
+// exit status in the pane frame.
+func ExitStatusString(msg *ExitMessage) string {
+	if msg.Signal != "" {
+		return fmt.Sprintf("killed by %s", msg.Signal)
+	}
+	return fmt.Sprintf("exited %d", msg.Code)
+}
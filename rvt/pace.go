@@ -0,0 +1,101 @@
+package rvt
+
+import "time"
+
+// Bounds on the rate frames are sent to a peer, at 60 and 2 frames per
+// second.
+const (
+	minFrameInterval = time.Second / 60
+	maxFrameInterval = time.Second / 2
+)
+
+const (
+	// framesInFlight is how many frames may be sent to a peer before it
+	// acknowledges the first of them.
+	framesInFlight = 2
+
+	// ackTimeout is how long a frame may go unacknowledged before it is
+	// assumed lost, so that a peer that stops acknowledging is still sent
+	// frames at the slowest rate.
+	ackTimeout = 2 * time.Second
+)
+
+// pacer decides when the next frame may be sent to a peer. Frames are spaced
+// by an interval that adapts to the round-trip time measured from the peer's
+// acknowledgements, and held back while earlier frames are still queued or in
+// flight. Peers that never acknowledge frames are paced by the send queue
+// alone.
+type pacer struct {
+	interval time.Duration
+	srtt     time.Duration
+	last     time.Time
+
+	frame    uint64
+	acked    bool
+	inflight []sentFrame
+}
+
+type sentFrame struct {
+	frame uint64
+	at    time.Time
+}
+
+func newPacer() *pacer {
+	return &pacer{interval: minFrameInterval}
+}
+
+// delay returns how long to wait before sending the next frame, with queued
+// messages still waiting to be sent to the peer, or zero to send it now.
+func (p *pacer) delay(now time.Time, queued int) time.Duration {
+	for len(p.inflight) > 0 && now.Sub(p.inflight[0].at) >= ackTimeout {
+		p.inflight = p.inflight[1:]
+	}
+
+	if queued > 0 {
+		return p.interval
+	}
+	if p.acked && len(p.inflight) >= framesInFlight {
+		return p.inflight[0].at.Add(ackTimeout).Sub(now)
+	}
+	if d := p.last.Add(p.interval).Sub(now); d > 0 {
+		return d
+	}
+	return 0
+}
+
+// sent records a frame sent at now, returning its frame number.
+func (p *pacer) sent(now time.Time) uint64 {
+	p.frame++
+	p.last = now
+	p.inflight = append(p.inflight, sentFrame{frame: p.frame, at: now})
+	return p.frame
+}
+
+// ack records that the peer drew frame at now, and the frames before it.
+func (p *pacer) ack(frame uint64, now time.Time) {
+	p.acked = true
+	for len(p.inflight) > 0 && p.inflight[0].frame <= frame {
+		if p.inflight[0].frame == frame {
+			p.sample(now.Sub(p.inflight[0].at))
+		}
+		p.inflight = p.inflight[1:]
+	}
+}
+
+// sample updates the smoothed round-trip time with rtt, as TCP does, and
+// spreads the frames in flight over it.
+func (p *pacer) sample(rtt time.Duration) {
+	if p.srtt == 0 {
+		p.srtt = rtt
+	} else {
+		p.srtt = (7*p.srtt + rtt) / 8
+	}
+
+	p.interval = p.srtt / framesInFlight
+	if p.interval < minFrameInterval {
+		p.interval = minFrameInterval
+	}
+	if p.interval > maxFrameInterval {
+		p.interval = maxFrameInterval
+	}
+}
@@ -0,0 +1,106 @@
+package rvt
+
+import (
+	"testing"
+	"time"
+)
+
+func TestPacer(t *testing.T) {
+	const ms = time.Millisecond
+
+	// A step sends a frame, acknowledges one, or checks the delay before the
+	// next frame with queued messages waiting.
+	type step struct {
+		at     time.Duration
+		op     string
+		frame  uint64
+		queued int
+		delay  time.Duration
+	}
+	for _, tc := range []struct {
+		name  string
+		steps []step
+	}{{
+		name: "fastest rate",
+		steps: []step{
+			{at: 0, op: "delay", delay: 0},
+			{at: 0, op: "send"},
+			{at: 5 * ms, op: "delay", delay: minFrameInterval - 5*ms},
+			{at: minFrameInterval, op: "delay", delay: 0},
+		},
+	}, {
+		name: "queued messages",
+		steps: []step{
+			{at: 0, op: "send"},
+			{at: 100 * ms, op: "delay", queued: 1, delay: minFrameInterval},
+			{at: 100 * ms, op: "delay", delay: 0},
+		},
+	}, {
+		name: "round-trip time spreads frames",
+		steps: []step{
+			{at: 0, op: "send"},
+			{at: 200 * ms, op: "ack", frame: 1},
+			{at: 200 * ms, op: "delay", delay: 0},
+			{at: 200 * ms, op: "send"},
+			{at: 250 * ms, op: "delay", delay: 50 * ms},
+		},
+	}, {
+		name: "ack of a later frame",
+		steps: []step{
+			{at: 0, op: "send"},
+			{at: 20 * ms, op: "send"},
+			{at: 100 * ms, op: "ack", frame: 2},
+			{at: 100 * ms, op: "send"},
+			{at: 110 * ms, op: "delay", delay: 30 * ms},
+		},
+	}, {
+		name: "slowest rate",
+		steps: []step{
+			{at: 0, op: "send"},
+			{at: 3 * time.Second, op: "ack", frame: 1},
+			{at: 3 * time.Second, op: "send"},
+			{at: 3100 * ms, op: "delay", delay: 400 * ms},
+		},
+	}, {
+		name: "frames in flight",
+		steps: []step{
+			{at: 0, op: "send"},
+			{at: 20 * ms, op: "ack", frame: 1},
+			{at: 40 * ms, op: "send"},
+			{at: 60 * ms, op: "send"},
+			{at: 80 * ms, op: "delay", delay: ackTimeout - 40*ms},
+			// The oldest frame is assumed lost once it times out.
+			{at: 40*ms + ackTimeout, op: "delay", delay: 0},
+		},
+	}, {
+		name: "never acknowledged",
+		steps: []step{
+			{at: 0, op: "send"},
+			{at: 20 * ms, op: "send"},
+			{at: 40 * ms, op: "send"},
+			{at: 60 * ms, op: "delay", delay: 0},
+		},
+	}} {
+		var (
+			p     = newPacer()
+			start = time.Now()
+			frame uint64
+		)
+		for i, s := range tc.steps {
+			now := start.Add(s.at)
+			switch s.op {
+			case "send":
+				frame++
+				if got := p.sent(now); got != frame {
+					t.Errorf("%s: step %d sent frame %d, expected %d", tc.name, i, got, frame)
+				}
+			case "ack":
+				p.ack(s.frame, now)
+			case "delay":
+				if got := p.delay(now, s.queued); got != s.delay {
+					t.Errorf("%s: step %d delayed %s, expected %s", tc.name, i, got, s.delay)
+				}
+			}
+		}
+	}
+}
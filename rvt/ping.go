@@ -0,0 +1,75 @@
+package rvt
+
+import "time"
+
+// PingInterval is how often each side of a stream pings the other.
+const PingInterval = 2 * time.Second
+
+// PeerStats describes the connection to a peer, as measured by the host.
+type PeerStats struct {
+	// RTT is the smoothed round-trip time of pings, or of frames for peers
+	// that don't answer pings.
+	RTT time.Duration
+
+	// Conn is how the peer is connected, such as directly or through a
+	// relay, or empty if it isn't known.
+	Conn string
+
+	// BytesPerSec and FramesPerSec are the rates the peer was sent messages
+	// and frames at since its stats were last reported.
+	BytesPerSec  float64
+	FramesPerSec float64
+}
+
+// StatsReporter is implemented by screens that show how each peer is
+// connected. ReportStats is called every PingInterval while a peer is
+// subscribed.
+type StatsReporter interface {
+	ReportStats(id string, stats PeerStats)
+}
+
+// NewPingMessage returns ping number seq, sent at now.
+func NewPingMessage(seq uint64, now time.Time) *PingMessage {
+	return &PingMessage{Seq: seq, Time: now.UnixNano()}
+}
+
+// Pong returns the reply to the ping m.
+func (m *PingMessage) Pong() *PingMessage {
+	return &PingMessage{Seq: m.Seq, Time: m.Time, Reply: true}
+}
+
+// RTT returns the round-trip time of the ping answered by the reply m, which
+// was received at now.
+func (m *PingMessage) RTT(now time.Time) time.Duration {
+	return now.Sub(time.Unix(0, m.Time))
+}
+
+// meter measures the round-trip time of pings and the rates a peer is sent
+// bytes and frames at.
+type meter struct {
+	srtt   time.Duration
+	last   time.Time
+	bytes  uint64
+	frames uint64
+}
+
+// sample updates the smoothed round-trip time with rtt, as the pacer does.
+func (m *meter) sample(rtt time.Duration) {
+	if m.srtt == 0 {
+		m.srtt = rtt
+	} else {
+		m.srtt = (7*m.srtt + rtt) / 8
+	}
+}
+
+// rates returns the rates bytes and frames were sent at since the previous
+// call, given the totals sent by now.
+func (m *meter) rates(now time.Time, bytes, frames uint64) (float64, float64) {
+	var bps, fps float64
+	if secs := now.Sub(m.last).Seconds(); !m.last.IsZero() && secs > 0 {
+		bps = float64(bytes-m.bytes) / secs
+		fps = float64(frames-m.frames) / secs
+	}
+	m.last, m.bytes, m.frames = now, bytes, frames
+	return bps, fps
+}
@@ -4,6 +4,7 @@
 package rvt
 
 import (
+	bytes "bytes"
 	context "context"
 	fmt "fmt"
 	proto "github.com/gogo/protobuf/proto"
@@ -34,6 +35,12 @@ type ShareMessage struct {
 	//	*ShareMessage_Init
 	//	*ShareMessage_Render
 	//	*ShareMessage_Event
+	//	*ShareMessage_Exit
+	//	*ShareMessage_Bell
+	//	*ShareMessage_Leave
+	//	*ShareMessage_Ack
+	//	*ShareMessage_Ping
+	//	*ShareMessage_Clipboard
 	Message isShareMessage_Message `protobuf_oneof:"Message"`
 }
 
@@ -85,10 +92,34 @@ type ShareMessage_Render struct {
 type ShareMessage_Event struct {
 	Event *EventMessage `protobuf:"bytes,4,opt,name=Event,proto3,oneof" json:"Event,omitempty"`
 }
+type ShareMessage_Exit struct {
+	Exit *ExitMessage `protobuf:"bytes,5,opt,name=Exit,proto3,oneof" json:"Exit,omitempty"`
+}
+type ShareMessage_Bell struct {
+	Bell *BellMessage `protobuf:"bytes,6,opt,name=Bell,proto3,oneof" json:"Bell,omitempty"`
+}
+type ShareMessage_Leave struct {
+	Leave *LeaveMessage `protobuf:"bytes,7,opt,name=Leave,proto3,oneof" json:"Leave,omitempty"`
+}
+type ShareMessage_Ack struct {
+	Ack *AckMessage `protobuf:"bytes,8,opt,name=Ack,proto3,oneof" json:"Ack,omitempty"`
+}
+type ShareMessage_Ping struct {
+	Ping *PingMessage `protobuf:"bytes,9,opt,name=Ping,proto3,oneof" json:"Ping,omitempty"`
+}
+type ShareMessage_Clipboard struct {
+	Clipboard *ClipboardMessage `protobuf:"bytes,10,opt,name=Clipboard,proto3,oneof" json:"Clipboard,omitempty"`
+}
 
-func (*ShareMessage_Init) isShareMessage_Message()   {}
-func (*ShareMessage_Render) isShareMessage_Message() {}
-func (*ShareMessage_Event) isShareMessage_Message()  {}
+func (*ShareMessage_Init) isShareMessage_Message()      {}
+func (*ShareMessage_Render) isShareMessage_Message()    {}
+func (*ShareMessage_Event) isShareMessage_Message()     {}
+func (*ShareMessage_Exit) isShareMessage_Message()      {}
+func (*ShareMessage_Bell) isShareMessage_Message()      {}
+func (*ShareMessage_Leave) isShareMessage_Message()     {}
+func (*ShareMessage_Ack) isShareMessage_Message()       {}
+func (*ShareMessage_Ping) isShareMessage_Message()      {}
+func (*ShareMessage_Clipboard) isShareMessage_Message() {}
 
 func (m *ShareMessage) GetMessage() isShareMessage_Message {
 	if m != nil {
@@ -125,16 +156,82 @@ func (m *ShareMessage) GetEvent() *EventMessage {
 	return nil
 }
 
+func (m *ShareMessage) GetExit() *ExitMessage {
+	if x, ok := m.GetMessage().(*ShareMessage_Exit); ok {
+		return x.Exit
+	}
+	return nil
+}
+
+func (m *ShareMessage) GetBell() *BellMessage {
+	if x, ok := m.GetMessage().(*ShareMessage_Bell); ok {
+		return x.Bell
+	}
+	return nil
+}
+
+func (m *ShareMessage) GetLeave() *LeaveMessage {
+	if x, ok := m.GetMessage().(*ShareMessage_Leave); ok {
+		return x.Leave
+	}
+	return nil
+}
+
+func (m *ShareMessage) GetAck() *AckMessage {
+	if x, ok := m.GetMessage().(*ShareMessage_Ack); ok {
+		return x.Ack
+	}
+	return nil
+}
+
+func (m *ShareMessage) GetPing() *PingMessage {
+	if x, ok := m.GetMessage().(*ShareMessage_Ping); ok {
+		return x.Ping
+	}
+	return nil
+}
+
+func (m *ShareMessage) GetClipboard() *ClipboardMessage {
+	if x, ok := m.GetMessage().(*ShareMessage_Clipboard); ok {
+		return x.Clipboard
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*ShareMessage) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
 		(*ShareMessage_Init)(nil),
 		(*ShareMessage_Render)(nil),
 		(*ShareMessage_Event)(nil),
+		(*ShareMessage_Exit)(nil),
+		(*ShareMessage_Bell)(nil),
+		(*ShareMessage_Leave)(nil),
+		(*ShareMessage_Ack)(nil),
+		(*ShareMessage_Ping)(nil),
+		(*ShareMessage_Clipboard)(nil),
 	}
 }
 
+// InitMessage is sent by a peer to start sharing the screen, and sent back by
+// the host with the token the peer can resume its session with if the stream
+// drops. Each side gives the version of the protocol it speaks, the oldest
+// version it can still speak and the features it supports. The host ends the
+// stream with FailedPrecondition if it can't speak the peer's version, and
+// the peer gives up if it can't speak the host's.
 type InitMessage struct {
+	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	// Version is the version of the protocol, which is 1 if it is unset.
+	Version  uint32   `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	Features []string `protobuf:"bytes,3,rep,name=features,proto3" json:"features,omitempty"`
+	// Cols and rows are the size of the peer's terminal.
+	Cols int32 `protobuf:"varint,4,opt,name=cols,proto3" json:"cols,omitempty"`
+	Rows int32 `protobuf:"varint,5,opt,name=rows,proto3" json:"rows,omitempty"`
+	// Colors is the number of colors the peer's terminal can show.
+	Colors int32 `protobuf:"varint,6,opt,name=colors,proto3" json:"colors,omitempty"`
+	// MinVersion is the oldest version of the protocol the sender can speak
+	// with the other side, which is 1 if it is unset.
+	MinVersion uint32 `protobuf:"varint,7,opt,name=min_version,json=minVersion,proto3" json:"min_version,omitempty"`
 }
 
 func (m *InitMessage) Reset()      { *m = InitMessage{} }
@@ -169,87 +266,73 @@ func (m *InitMessage) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_InitMessage proto.InternalMessageInfo
 
-type RenderMessage struct {
-	Cols   int32    `protobuf:"varint,1,opt,name=cols,proto3" json:"cols,omitempty"`
-	Rows   int32    `protobuf:"varint,2,opt,name=rows,proto3" json:"rows,omitempty"`
-	Glyphs []*Glyph `protobuf:"bytes,3,rep,name=glyphs,proto3" json:"glyphs,omitempty"`
+func (m *InitMessage) GetToken() string {
+	if m != nil {
+		return m.Token
+	}
+	return ""
 }
 
-func (m *RenderMessage) Reset()      { *m = RenderMessage{} }
-func (*RenderMessage) ProtoMessage() {}
-func (*RenderMessage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ce9e79630956d2f5, []int{2}
-}
-func (m *RenderMessage) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
-}
-func (m *RenderMessage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_RenderMessage.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
+func (m *InitMessage) GetVersion() uint32 {
+	if m != nil {
+		return m.Version
 	}
-}
-func (m *RenderMessage) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RenderMessage.Merge(m, src)
-}
-func (m *RenderMessage) XXX_Size() int {
-	return m.Size()
-}
-func (m *RenderMessage) XXX_DiscardUnknown() {
-	xxx_messageInfo_RenderMessage.DiscardUnknown(m)
+	return 0
 }
 
-var xxx_messageInfo_RenderMessage proto.InternalMessageInfo
+func (m *InitMessage) GetFeatures() []string {
+	if m != nil {
+		return m.Features
+	}
+	return nil
+}
 
-func (m *RenderMessage) GetCols() int32 {
+func (m *InitMessage) GetCols() int32 {
 	if m != nil {
 		return m.Cols
 	}
 	return 0
 }
 
-func (m *RenderMessage) GetRows() int32 {
+func (m *InitMessage) GetRows() int32 {
 	if m != nil {
 		return m.Rows
 	}
 	return 0
 }
 
-func (m *RenderMessage) GetGlyphs() []*Glyph {
+func (m *InitMessage) GetColors() int32 {
 	if m != nil {
-		return m.Glyphs
+		return m.Colors
 	}
-	return nil
+	return 0
 }
 
-type Glyph struct {
-	X        int32   `protobuf:"varint,1,opt,name=x,proto3" json:"x,omitempty"`
-	Y        int32   `protobuf:"varint,2,opt,name=y,proto3" json:"y,omitempty"`
-	Mainc    int32   `protobuf:"varint,3,opt,name=mainc,proto3" json:"mainc,omitempty"`
-	Combc    []int32 `protobuf:"varint,4,rep,packed,name=combc,proto3" json:"combc,omitempty"`
-	Fg       uint64  `protobuf:"varint,5,opt,name=fg,proto3" json:"fg,omitempty"`
-	Bg       uint64  `protobuf:"varint,6,opt,name=bg,proto3" json:"bg,omitempty"`
-	AttrMask int32   `protobuf:"varint,7,opt,name=attr_mask,json=attrMask,proto3" json:"attr_mask,omitempty"`
-	Width    int32   `protobuf:"varint,8,opt,name=width,proto3" json:"width,omitempty"`
+func (m *InitMessage) GetMinVersion() uint32 {
+	if m != nil {
+		return m.MinVersion
+	}
+	return 0
 }
 
-func (m *Glyph) Reset()      { *m = Glyph{} }
-func (*Glyph) ProtoMessage() {}
-func (*Glyph) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ce9e79630956d2f5, []int{3}
+type ExitMessage struct {
+	Title  string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Code   int32  `protobuf:"varint,2,opt,name=code,proto3" json:"code,omitempty"`
+	Signal string `protobuf:"bytes,3,opt,name=signal,proto3" json:"signal,omitempty"`
+	Pane   int32  `protobuf:"varint,4,opt,name=pane,proto3" json:"pane,omitempty"`
 }
-func (m *Glyph) XXX_Unmarshal(b []byte) error {
+
+func (m *ExitMessage) Reset()      { *m = ExitMessage{} }
+func (*ExitMessage) ProtoMessage() {}
+func (*ExitMessage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce9e79630956d2f5, []int{2}
+}
+func (m *ExitMessage) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Glyph) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ExitMessage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Glyph.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ExitMessage.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -259,94 +342,108 @@ func (m *Glyph) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *Glyph) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Glyph.Merge(m, src)
+func (m *ExitMessage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExitMessage.Merge(m, src)
 }
-func (m *Glyph) XXX_Size() int {
+func (m *ExitMessage) XXX_Size() int {
 	return m.Size()
 }
-func (m *Glyph) XXX_DiscardUnknown() {
-	xxx_messageInfo_Glyph.DiscardUnknown(m)
+func (m *ExitMessage) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExitMessage.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Glyph proto.InternalMessageInfo
-
-func (m *Glyph) GetX() int32 {
-	if m != nil {
-		return m.X
-	}
-	return 0
-}
+var xxx_messageInfo_ExitMessage proto.InternalMessageInfo
 
-func (m *Glyph) GetY() int32 {
+func (m *ExitMessage) GetTitle() string {
 	if m != nil {
-		return m.Y
+		return m.Title
 	}
-	return 0
+	return ""
 }
 
-func (m *Glyph) GetMainc() int32 {
+func (m *ExitMessage) GetCode() int32 {
 	if m != nil {
-		return m.Mainc
+		return m.Code
 	}
 	return 0
 }
 
-func (m *Glyph) GetCombc() []int32 {
+func (m *ExitMessage) GetSignal() string {
 	if m != nil {
-		return m.Combc
+		return m.Signal
 	}
-	return nil
+	return ""
 }
 
-func (m *Glyph) GetFg() uint64 {
+func (m *ExitMessage) GetPane() int32 {
 	if m != nil {
-		return m.Fg
+		return m.Pane
 	}
 	return 0
 }
 
-func (m *Glyph) GetBg() uint64 {
-	if m != nil {
-		return m.Bg
-	}
-	return 0
+// BellMessage is sent when the process in a pane rings the bell.
+type BellMessage struct {
+	Pane int32 `protobuf:"varint,1,opt,name=pane,proto3" json:"pane,omitempty"`
 }
 
-func (m *Glyph) GetAttrMask() int32 {
-	if m != nil {
-		return m.AttrMask
+func (m *BellMessage) Reset()      { *m = BellMessage{} }
+func (*BellMessage) ProtoMessage() {}
+func (*BellMessage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce9e79630956d2f5, []int{3}
+}
+func (m *BellMessage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BellMessage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BellMessage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return 0
+}
+func (m *BellMessage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BellMessage.Merge(m, src)
+}
+func (m *BellMessage) XXX_Size() int {
+	return m.Size()
+}
+func (m *BellMessage) XXX_DiscardUnknown() {
+	xxx_messageInfo_BellMessage.DiscardUnknown(m)
 }
 
-func (m *Glyph) GetWidth() int32 {
+var xxx_messageInfo_BellMessage proto.InternalMessageInfo
+
+func (m *BellMessage) GetPane() int32 {
 	if m != nil {
-		return m.Width
+		return m.Pane
 	}
 	return 0
 }
 
-type EventMessage struct {
-	// Types that are valid to be assigned to Event:
-	//	*EventMessage_Mouse
-	//	*EventMessage_Key
-	//	*EventMessage_Resize
-	//	*EventMessage_Paste
-	Event isEventMessage_Event `protobuf_oneof:"Event"`
+// ClipboardMessage is sent to peers that support it when the process in a
+// pane sets the clipboard with OSC 52.
+type ClipboardMessage struct {
+	Pane int32  `protobuf:"varint,1,opt,name=pane,proto3" json:"pane,omitempty"`
+	Data []byte `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
 }
 
-func (m *EventMessage) Reset()      { *m = EventMessage{} }
-func (*EventMessage) ProtoMessage() {}
-func (*EventMessage) Descriptor() ([]byte, []int) {
+func (m *ClipboardMessage) Reset()      { *m = ClipboardMessage{} }
+func (*ClipboardMessage) ProtoMessage() {}
+func (*ClipboardMessage) Descriptor() ([]byte, []int) {
 	return fileDescriptor_ce9e79630956d2f5, []int{4}
 }
-func (m *EventMessage) XXX_Unmarshal(b []byte) error {
+func (m *ClipboardMessage) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventMessage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ClipboardMessage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventMessage.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ClipboardMessage.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -356,106 +453,49 @@ func (m *EventMessage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *EventMessage) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventMessage.Merge(m, src)
+func (m *ClipboardMessage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClipboardMessage.Merge(m, src)
 }
-func (m *EventMessage) XXX_Size() int {
+func (m *ClipboardMessage) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventMessage) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventMessage.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_EventMessage proto.InternalMessageInfo
-
-type isEventMessage_Event interface {
-	isEventMessage_Event()
-	Equal(interface{}) bool
-	MarshalTo([]byte) (int, error)
-	Size() int
-}
-
-type EventMessage_Mouse struct {
-	Mouse *EventMouse `protobuf:"bytes,1,opt,name=Mouse,proto3,oneof" json:"Mouse,omitempty"`
-}
-type EventMessage_Key struct {
-	Key *EventKey `protobuf:"bytes,2,opt,name=Key,proto3,oneof" json:"Key,omitempty"`
-}
-type EventMessage_Resize struct {
-	Resize *EventResize `protobuf:"bytes,3,opt,name=Resize,proto3,oneof" json:"Resize,omitempty"`
-}
-type EventMessage_Paste struct {
-	Paste *EventPaste `protobuf:"bytes,4,opt,name=Paste,proto3,oneof" json:"Paste,omitempty"`
+func (m *ClipboardMessage) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClipboardMessage.DiscardUnknown(m)
 }
 
-func (*EventMessage_Mouse) isEventMessage_Event()  {}
-func (*EventMessage_Key) isEventMessage_Event()    {}
-func (*EventMessage_Resize) isEventMessage_Event() {}
-func (*EventMessage_Paste) isEventMessage_Event()  {}
+var xxx_messageInfo_ClipboardMessage proto.InternalMessageInfo
 
-func (m *EventMessage) GetEvent() isEventMessage_Event {
+func (m *ClipboardMessage) GetPane() int32 {
 	if m != nil {
-		return m.Event
-	}
-	return nil
-}
-
-func (m *EventMessage) GetMouse() *EventMouse {
-	if x, ok := m.GetEvent().(*EventMessage_Mouse); ok {
-		return x.Mouse
-	}
-	return nil
-}
-
-func (m *EventMessage) GetKey() *EventKey {
-	if x, ok := m.GetEvent().(*EventMessage_Key); ok {
-		return x.Key
+		return m.Pane
 	}
-	return nil
-}
-
-func (m *EventMessage) GetResize() *EventResize {
-	if x, ok := m.GetEvent().(*EventMessage_Resize); ok {
-		return x.Resize
-	}
-	return nil
+	return 0
 }
 
-func (m *EventMessage) GetPaste() *EventPaste {
-	if x, ok := m.GetEvent().(*EventMessage_Paste); ok {
-		return x.Paste
+func (m *ClipboardMessage) GetData() []byte {
+	if m != nil {
+		return m.Data
 	}
 	return nil
 }
 
-// XXX_OneofWrappers is for the internal use of the proto package.
-func (*EventMessage) XXX_OneofWrappers() []interface{} {
-	return []interface{}{
-		(*EventMessage_Mouse)(nil),
-		(*EventMessage_Key)(nil),
-		(*EventMessage_Resize)(nil),
-		(*EventMessage_Paste)(nil),
-	}
-}
-
-type EventMouse struct {
-	X          int32 `protobuf:"varint,1,opt,name=x,proto3" json:"x,omitempty"`
-	Y          int32 `protobuf:"varint,2,opt,name=y,proto3" json:"y,omitempty"`
-	ButtonMask int32 `protobuf:"varint,3,opt,name=button_mask,json=buttonMask,proto3" json:"button_mask,omitempty"`
-	ModMask    int32 `protobuf:"varint,4,opt,name=mod_mask,json=modMask,proto3" json:"mod_mask,omitempty"`
+// LeaveMessage is sent to the remaining peers when a peer leaves, either by
+// disconnecting or by being evicted after its connection went idle.
+type LeaveMessage struct {
+	Peer string `protobuf:"bytes,1,opt,name=peer,proto3" json:"peer,omitempty"`
 }
 
-func (m *EventMouse) Reset()      { *m = EventMouse{} }
-func (*EventMouse) ProtoMessage() {}
-func (*EventMouse) Descriptor() ([]byte, []int) {
+func (m *LeaveMessage) Reset()      { *m = LeaveMessage{} }
+func (*LeaveMessage) ProtoMessage() {}
+func (*LeaveMessage) Descriptor() ([]byte, []int) {
 	return fileDescriptor_ce9e79630956d2f5, []int{5}
 }
-func (m *EventMouse) XXX_Unmarshal(b []byte) error {
+func (m *LeaveMessage) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventMouse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *LeaveMessage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventMouse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_LeaveMessage.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -465,63 +505,142 @@ func (m *EventMouse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *EventMouse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventMouse.Merge(m, src)
+func (m *LeaveMessage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LeaveMessage.Merge(m, src)
 }
-func (m *EventMouse) XXX_Size() int {
+func (m *LeaveMessage) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventMouse) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventMouse.DiscardUnknown(m)
+func (m *LeaveMessage) XXX_DiscardUnknown() {
+	xxx_messageInfo_LeaveMessage.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventMouse proto.InternalMessageInfo
+var xxx_messageInfo_LeaveMessage proto.InternalMessageInfo
 
-func (m *EventMouse) GetX() int32 {
+func (m *LeaveMessage) GetPeer() string {
 	if m != nil {
-		return m.X
+		return m.Peer
 	}
-	return 0
+	return ""
 }
 
-func (m *EventMouse) GetY() int32 {
-	if m != nil {
-		return m.Y
-	}
+// RenderMessage is a frame of the screen. Its cells are sent as runs of text
+// sharing a style from the style table of the frame. Glyphs, one per cell,
+// are only read from hosts that predate runs.
+type RenderMessage struct {
+	Cols   int32    `protobuf:"varint,1,opt,name=cols,proto3" json:"cols,omitempty"`
+	Rows   int32    `protobuf:"varint,2,opt,name=rows,proto3" json:"rows,omitempty"`
+	Glyphs []*Glyph `protobuf:"bytes,3,rep,name=glyphs,proto3" json:"glyphs,omitempty"`
+	// Frame numbers the renders sent to a peer, which acknowledges them.
+	Frame  uint64   `protobuf:"varint,4,opt,name=frame,proto3" json:"frame,omitempty"`
+	Styles []*Style `protobuf:"bytes,5,rep,name=styles,proto3" json:"styles,omitempty"`
+	Runs   []*Run   `protobuf:"bytes,6,rep,name=runs,proto3" json:"runs,omitempty"`
+	// Cursor is where the cursor of the pane the peer has focused is, if it
+	// is shown.
+	Cursor *Cursor `protobuf:"bytes,7,opt,name=cursor,proto3" json:"cursor,omitempty"`
+}
+
+func (m *RenderMessage) Reset()      { *m = RenderMessage{} }
+func (*RenderMessage) ProtoMessage() {}
+func (*RenderMessage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce9e79630956d2f5, []int{6}
+}
+func (m *RenderMessage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RenderMessage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RenderMessage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RenderMessage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RenderMessage.Merge(m, src)
+}
+func (m *RenderMessage) XXX_Size() int {
+	return m.Size()
+}
+func (m *RenderMessage) XXX_DiscardUnknown() {
+	xxx_messageInfo_RenderMessage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RenderMessage proto.InternalMessageInfo
+
+func (m *RenderMessage) GetCols() int32 {
+	if m != nil {
+		return m.Cols
+	}
 	return 0
 }
 
-func (m *EventMouse) GetButtonMask() int32 {
+func (m *RenderMessage) GetRows() int32 {
 	if m != nil {
-		return m.ButtonMask
+		return m.Rows
 	}
 	return 0
 }
 
-func (m *EventMouse) GetModMask() int32 {
+func (m *RenderMessage) GetGlyphs() []*Glyph {
 	if m != nil {
-		return m.ModMask
+		return m.Glyphs
+	}
+	return nil
+}
+
+func (m *RenderMessage) GetFrame() uint64 {
+	if m != nil {
+		return m.Frame
 	}
 	return 0
 }
 
-type EventKey struct {
-	Key     int32 `protobuf:"varint,1,opt,name=key,proto3" json:"key,omitempty"`
-	Rune    int32 `protobuf:"varint,2,opt,name=rune,proto3" json:"rune,omitempty"`
-	ModMask int32 `protobuf:"varint,3,opt,name=mod_mask,json=modMask,proto3" json:"mod_mask,omitempty"`
+func (m *RenderMessage) GetStyles() []*Style {
+	if m != nil {
+		return m.Styles
+	}
+	return nil
 }
 
-func (m *EventKey) Reset()      { *m = EventKey{} }
-func (*EventKey) ProtoMessage() {}
-func (*EventKey) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ce9e79630956d2f5, []int{6}
+func (m *RenderMessage) GetRuns() []*Run {
+	if m != nil {
+		return m.Runs
+	}
+	return nil
 }
-func (m *EventKey) XXX_Unmarshal(b []byte) error {
+
+func (m *RenderMessage) GetCursor() *Cursor {
+	if m != nil {
+		return m.Cursor
+	}
+	return nil
+}
+
+// Cursor is a position on the screen of a peer.
+type Cursor struct {
+	X int32 `protobuf:"varint,1,opt,name=x,proto3" json:"x,omitempty"`
+	Y int32 `protobuf:"varint,2,opt,name=y,proto3" json:"y,omitempty"`
+	// Predict is set if the pane appears to be editing a line, so that the
+	// peer may echo printable keys before the host does.
+	Predict bool `protobuf:"varint,3,opt,name=predict,proto3" json:"predict,omitempty"`
+}
+
+func (m *Cursor) Reset()      { *m = Cursor{} }
+func (*Cursor) ProtoMessage() {}
+func (*Cursor) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce9e79630956d2f5, []int{7}
+}
+func (m *Cursor) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *Cursor) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventKey.Marshal(b, m, deterministic)
+		return xxx_messageInfo_Cursor.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -531,55 +650,56 @@ func (m *EventKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *EventKey) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventKey.Merge(m, src)
+func (m *Cursor) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Cursor.Merge(m, src)
 }
-func (m *EventKey) XXX_Size() int {
+func (m *Cursor) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventKey) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventKey.DiscardUnknown(m)
+func (m *Cursor) XXX_DiscardUnknown() {
+	xxx_messageInfo_Cursor.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventKey proto.InternalMessageInfo
+var xxx_messageInfo_Cursor proto.InternalMessageInfo
 
-func (m *EventKey) GetKey() int32 {
+func (m *Cursor) GetX() int32 {
 	if m != nil {
-		return m.Key
+		return m.X
 	}
 	return 0
 }
 
-func (m *EventKey) GetRune() int32 {
+func (m *Cursor) GetY() int32 {
 	if m != nil {
-		return m.Rune
+		return m.Y
 	}
 	return 0
 }
 
-func (m *EventKey) GetModMask() int32 {
+func (m *Cursor) GetPredict() bool {
 	if m != nil {
-		return m.ModMask
+		return m.Predict
 	}
-	return 0
+	return false
 }
 
-type EventResize struct {
-	Width  int32 `protobuf:"varint,1,opt,name=width,proto3" json:"width,omitempty"`
-	Height int32 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+type Style struct {
+	Fg       uint64 `protobuf:"varint,1,opt,name=fg,proto3" json:"fg,omitempty"`
+	Bg       uint64 `protobuf:"varint,2,opt,name=bg,proto3" json:"bg,omitempty"`
+	AttrMask int32  `protobuf:"varint,3,opt,name=attr_mask,json=attrMask,proto3" json:"attr_mask,omitempty"`
 }
 
-func (m *EventResize) Reset()      { *m = EventResize{} }
-func (*EventResize) ProtoMessage() {}
-func (*EventResize) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ce9e79630956d2f5, []int{7}
+func (m *Style) Reset()      { *m = Style{} }
+func (*Style) ProtoMessage() {}
+func (*Style) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce9e79630956d2f5, []int{8}
 }
-func (m *EventResize) XXX_Unmarshal(b []byte) error {
+func (m *Style) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventResize) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *Style) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventResize.Marshal(b, m, deterministic)
+		return xxx_messageInfo_Style.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -589,47 +709,60 @@ func (m *EventResize) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *EventResize) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventResize.Merge(m, src)
+func (m *Style) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Style.Merge(m, src)
 }
-func (m *EventResize) XXX_Size() int {
+func (m *Style) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventResize) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventResize.DiscardUnknown(m)
+func (m *Style) XXX_DiscardUnknown() {
+	xxx_messageInfo_Style.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventResize proto.InternalMessageInfo
+var xxx_messageInfo_Style proto.InternalMessageInfo
 
-func (m *EventResize) GetWidth() int32 {
+func (m *Style) GetFg() uint64 {
 	if m != nil {
-		return m.Width
+		return m.Fg
 	}
 	return 0
 }
 
-func (m *EventResize) GetHeight() int32 {
+func (m *Style) GetBg() uint64 {
 	if m != nil {
-		return m.Height
+		return m.Bg
 	}
 	return 0
 }
 
-type EventPaste struct {
-	Start bool `protobuf:"varint,1,opt,name=start,proto3" json:"start,omitempty"`
+func (m *Style) GetAttrMask() int32 {
+	if m != nil {
+		return m.AttrMask
+	}
+	return 0
 }
 
-func (m *EventPaste) Reset()      { *m = EventPaste{} }
-func (*EventPaste) ProtoMessage() {}
-func (*EventPaste) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ce9e79630956d2f5, []int{8}
+// Run is a run of cells on a row starting at x, one per rune of text, all
+// with the same style. A cell with combining characters is a run of its own.
+type Run struct {
+	X     int32   `protobuf:"varint,1,opt,name=x,proto3" json:"x,omitempty"`
+	Y     int32   `protobuf:"varint,2,opt,name=y,proto3" json:"y,omitempty"`
+	Style int32   `protobuf:"varint,3,opt,name=style,proto3" json:"style,omitempty"`
+	Text  string  `protobuf:"bytes,4,opt,name=text,proto3" json:"text,omitempty"`
+	Combc []int32 `protobuf:"varint,5,rep,packed,name=combc,proto3" json:"combc,omitempty"`
 }
-func (m *EventPaste) XXX_Unmarshal(b []byte) error {
+
+func (m *Run) Reset()      { *m = Run{} }
+func (*Run) ProtoMessage() {}
+func (*Run) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce9e79630956d2f5, []int{9}
+}
+func (m *Run) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventPaste) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *Run) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventPaste.Marshal(b, m, deterministic)
+		return xxx_messageInfo_Run.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -639,402 +772,749 @@ func (m *EventPaste) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *EventPaste) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventPaste.Merge(m, src)
+func (m *Run) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Run.Merge(m, src)
 }
-func (m *EventPaste) XXX_Size() int {
+func (m *Run) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventPaste) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventPaste.DiscardUnknown(m)
+func (m *Run) XXX_DiscardUnknown() {
+	xxx_messageInfo_Run.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventPaste proto.InternalMessageInfo
+var xxx_messageInfo_Run proto.InternalMessageInfo
 
-func (m *EventPaste) GetStart() bool {
+func (m *Run) GetX() int32 {
 	if m != nil {
-		return m.Start
+		return m.X
 	}
-	return false
+	return 0
 }
 
-func init() {
-	proto.RegisterType((*ShareMessage)(nil), "ptmux.rvt.v1.ShareMessage")
-	proto.RegisterType((*InitMessage)(nil), "ptmux.rvt.v1.InitMessage")
-	proto.RegisterType((*RenderMessage)(nil), "ptmux.rvt.v1.RenderMessage")
-	proto.RegisterType((*Glyph)(nil), "ptmux.rvt.v1.Glyph")
-	proto.RegisterType((*EventMessage)(nil), "ptmux.rvt.v1.EventMessage")
-	proto.RegisterType((*EventMouse)(nil), "ptmux.rvt.v1.EventMouse")
-	proto.RegisterType((*EventKey)(nil), "ptmux.rvt.v1.EventKey")
-	proto.RegisterType((*EventResize)(nil), "ptmux.rvt.v1.EventResize")
-	proto.RegisterType((*EventPaste)(nil), "ptmux.rvt.v1.EventPaste")
+func (m *Run) GetY() int32 {
+	if m != nil {
+		return m.Y
+	}
+	return 0
 }
 
-func init() { proto.RegisterFile("rvt.proto", fileDescriptor_ce9e79630956d2f5) }
+func (m *Run) GetStyle() int32 {
+	if m != nil {
+		return m.Style
+	}
+	return 0
+}
 
-var fileDescriptor_ce9e79630956d2f5 = []byte{
-	// 605 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x54, 0xc1, 0x6e, 0xd3, 0x4c,
-	0x10, 0xf6, 0xc6, 0xb1, 0x93, 0x8c, 0xd3, 0x5f, 0xbf, 0x16, 0x54, 0xb9, 0xad, 0xb4, 0x44, 0x3e,
-	0x45, 0x20, 0x85, 0x92, 0x8a, 0x03, 0xe2, 0x56, 0x84, 0x28, 0xaa, 0x22, 0x21, 0xf7, 0xc6, 0x05,
-	0x39, 0xf1, 0xd6, 0xb1, 0x5a, 0xdb, 0x95, 0xbd, 0x49, 0x6b, 0x4e, 0x3c, 0x02, 0xcf, 0xc0, 0x89,
-	0x17, 0x41, 0xe2, 0xd8, 0x63, 0x0f, 0x1c, 0xa8, 0x73, 0xe1, 0xd8, 0x47, 0x40, 0x3b, 0xbb, 0x10,
-	0x1b, 0x45, 0xbd, 0xcd, 0xcc, 0x37, 0xdf, 0x78, 0xf6, 0xfb, 0x76, 0x0d, 0xbd, 0x7c, 0x29, 0x46,
-	0x17, 0x79, 0x26, 0x32, 0xda, 0xbf, 0x10, 0xc9, 0xe2, 0x6a, 0x24, 0x0b, 0xcb, 0x67, 0xde, 0x37,
-	0x02, 0xfd, 0x93, 0x79, 0x90, 0xf3, 0x09, 0x2f, 0x8a, 0x20, 0xe2, 0xf4, 0x3f, 0x68, 0xc5, 0xa1,
-	0x4b, 0x06, 0x64, 0xd8, 0xf3, 0x5b, 0x71, 0x48, 0x9f, 0x42, 0xfb, 0x6d, 0x1a, 0x0b, 0xb7, 0x35,
-	0x20, 0x43, 0x67, 0xbc, 0x33, 0xaa, 0xb3, 0x47, 0x12, 0xd1, 0xc4, 0x23, 0xc3, 0xc7, 0x46, 0xfa,
-	0x1c, 0x6c, 0x9f, 0xa7, 0x21, 0xcf, 0x5d, 0x13, 0x29, 0x7b, 0x4d, 0x8a, 0xc2, 0xd6, 0x24, 0xdd,
-	0x4c, 0xc7, 0x60, 0xbd, 0x5e, 0xf2, 0x54, 0xb8, 0x6d, 0x64, 0xed, 0x36, 0x59, 0x08, 0xad, 0x49,
-	0xaa, 0xf5, 0xb0, 0x07, 0x1d, 0x5d, 0xf3, 0xb6, 0xc0, 0xa9, 0x2d, 0xe3, 0x85, 0xb0, 0xd5, 0xf8,
-	0x10, 0xa5, 0xd0, 0x9e, 0x65, 0xe7, 0x05, 0x1e, 0xcc, 0xf2, 0x31, 0x96, 0xb5, 0x3c, 0xbb, 0x2c,
-	0xf0, 0x68, 0x96, 0x8f, 0x31, 0x7d, 0x02, 0x76, 0x74, 0x5e, 0x5e, 0xcc, 0x0b, 0xd7, 0x1c, 0x98,
-	0x43, 0x67, 0xfc, 0xa0, 0xb9, 0xc7, 0x1b, 0x89, 0xf9, 0xba, 0xc5, 0xfb, 0x42, 0xc0, 0xc2, 0x0a,
-	0xed, 0x03, 0xb9, 0xd2, 0xb3, 0xc9, 0x95, 0xcc, 0x4a, 0x3d, 0x95, 0x94, 0xf4, 0x21, 0x58, 0x49,
-	0x10, 0xa7, 0x33, 0xd4, 0xc3, 0xf2, 0x55, 0x22, 0xab, 0xb3, 0x2c, 0x99, 0xce, 0xdc, 0xf6, 0xc0,
-	0x94, 0x55, 0x4c, 0xa4, 0xfa, 0xa7, 0x91, 0x6b, 0x0d, 0xc8, 0xb0, 0xed, 0xb7, 0x4e, 0x23, 0x99,
-	0x4f, 0x23, 0xd7, 0x56, 0xf9, 0x34, 0xa2, 0x7b, 0xd0, 0x0b, 0x84, 0xc8, 0x3f, 0x24, 0x41, 0x71,
-	0xe6, 0x76, 0x70, 0x5e, 0x57, 0x16, 0x26, 0x41, 0x71, 0x26, 0x47, 0x5e, 0xc6, 0xa1, 0x98, 0xbb,
-	0x5d, 0xf5, 0x21, 0x4c, 0xbc, 0x1f, 0x04, 0xfa, 0x75, 0xf9, 0xe8, 0x3e, 0x58, 0x93, 0x6c, 0x51,
-	0x70, 0xdc, 0xd7, 0x19, 0xbb, 0x9b, 0x94, 0x96, 0xb8, 0xd4, 0x19, 0x03, 0xfa, 0x18, 0xcc, 0x63,
-	0x5e, 0xea, 0x2b, 0xb0, 0xbd, 0xa1, 0xff, 0x98, 0x97, 0x47, 0x86, 0x2f, 0x9b, 0xe8, 0x81, 0xb4,
-	0xbf, 0x88, 0x3f, 0x72, 0x6d, 0xff, 0xce, 0x86, 0x76, 0xd5, 0xa0, 0xcc, 0x97, 0x91, 0x5c, 0xe9,
-	0x5d, 0x50, 0x08, 0xae, 0xcd, 0xdf, 0xb4, 0x12, 0xe2, 0x72, 0x25, 0x0c, 0x0e, 0x3b, 0xfa, 0xba,
-	0x78, 0x21, 0xc0, 0x7a, 0xe5, 0x7b, 0x7d, 0x78, 0x04, 0xce, 0x74, 0x21, 0x44, 0x96, 0x2a, 0xf5,
-	0x94, 0x1b, 0xa0, 0x4a, 0xa8, 0xdf, 0x0e, 0x74, 0x93, 0x2c, 0x54, 0x68, 0x1b, 0xd1, 0x4e, 0x92,
-	0x85, 0x12, 0xf2, 0x8e, 0xa1, 0xfb, 0xe7, 0xa0, 0xf4, 0x7f, 0x30, 0xcf, 0x78, 0xa9, 0xbf, 0x22,
-	0x43, 0xbc, 0x48, 0x8b, 0x94, 0xff, 0xbd, 0x48, 0x8b, 0x94, 0x37, 0x86, 0x99, 0xcd, 0x61, 0x2f,
-	0xc1, 0xa9, 0xc9, 0xb0, 0xb6, 0x8d, 0xd4, 0x6c, 0xa3, 0xdb, 0x60, 0xcf, 0x79, 0x1c, 0xcd, 0x85,
-	0x9e, 0xaa, 0x33, 0xcf, 0xd3, 0xe7, 0x45, 0x19, 0x24, 0xb7, 0x10, 0x41, 0x2e, 0x90, 0xdb, 0xf5,
-	0x55, 0x32, 0x9e, 0x80, 0x7d, 0x32, 0xcb, 0x39, 0x4f, 0xe9, 0x2b, 0xb0, 0xf0, 0x75, 0xd3, 0x7f,
-	0xde, 0x53, 0xfd, 0xc9, 0xef, 0xde, 0x83, 0x0d, 0xc9, 0x3e, 0x39, 0x7c, 0x71, 0x7d, 0xcb, 0x8c,
-	0x9b, 0x5b, 0x66, 0xdc, 0xdd, 0x32, 0xf2, 0xa9, 0x62, 0xe4, 0x6b, 0xc5, 0xc8, 0xf7, 0x8a, 0x91,
-	0xeb, 0x8a, 0x91, 0x9f, 0x15, 0x23, 0xbf, 0x2a, 0x66, 0xdc, 0x55, 0x8c, 0x7c, 0x5e, 0x31, 0xe3,
-	0x7a, 0xc5, 0x8c, 0x9b, 0x15, 0x33, 0xde, 0x9b, 0xf9, 0x52, 0x4c, 0x6d, 0xfc, 0xe7, 0x1c, 0xfc,
-	0x0e, 0x00, 0x00, 0xff, 0xff, 0xcc, 0x76, 0x51, 0xd5, 0x80, 0x04, 0x00, 0x00,
+func (m *Run) GetText() string {
+	if m != nil {
+		return m.Text
+	}
+	return ""
 }
 
-func (this *ShareMessage) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+func (m *Run) GetCombc() []int32 {
+	if m != nil {
+		return m.Combc
 	}
+	return nil
+}
 
-	that1, ok := that.(*ShareMessage)
-	if !ok {
-		that2, ok := that.(ShareMessage)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+// AckMessage is sent by a peer once it has drawn a frame, so that the host
+// can measure the round-trip time and pace the frames it sends.
+type AckMessage struct {
+	Frame uint64 `protobuf:"varint,1,opt,name=frame,proto3" json:"frame,omitempty"`
+}
+
+func (m *AckMessage) Reset()      { *m = AckMessage{} }
+func (*AckMessage) ProtoMessage() {}
+func (*AckMessage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce9e79630956d2f5, []int{10}
+}
+func (m *AckMessage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AckMessage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AckMessage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.Id != that1.Id {
-		return false
+}
+func (m *AckMessage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AckMessage.Merge(m, src)
+}
+func (m *AckMessage) XXX_Size() int {
+	return m.Size()
+}
+func (m *AckMessage) XXX_DiscardUnknown() {
+	xxx_messageInfo_AckMessage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AckMessage proto.InternalMessageInfo
+
+func (m *AckMessage) GetFrame() uint64 {
+	if m != nil {
+		return m.Frame
 	}
-	if that1.Message == nil {
-		if this.Message != nil {
-			return false
+	return 0
+}
+
+// PingMessage is sent periodically by both sides to measure the round-trip
+// time, and sent back unchanged except for reply by the other side. Time is
+// when the ping was sent by the clock of its sender, in nanoseconds since the
+// Unix epoch.
+type PingMessage struct {
+	Seq   uint64 `protobuf:"varint,1,opt,name=seq,proto3" json:"seq,omitempty"`
+	Time  int64  `protobuf:"varint,2,opt,name=time,proto3" json:"time,omitempty"`
+	Reply bool   `protobuf:"varint,3,opt,name=reply,proto3" json:"reply,omitempty"`
+}
+
+func (m *PingMessage) Reset()      { *m = PingMessage{} }
+func (*PingMessage) ProtoMessage() {}
+func (*PingMessage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce9e79630956d2f5, []int{11}
+}
+func (m *PingMessage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PingMessage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PingMessage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-	} else if this.Message == nil {
-		return false
-	} else if !this.Message.Equal(that1.Message) {
-		return false
+		return b[:n], nil
 	}
-	return true
 }
-func (this *ShareMessage_Init) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+func (m *PingMessage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PingMessage.Merge(m, src)
+}
+func (m *PingMessage) XXX_Size() int {
+	return m.Size()
+}
+func (m *PingMessage) XXX_DiscardUnknown() {
+	xxx_messageInfo_PingMessage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PingMessage proto.InternalMessageInfo
+
+func (m *PingMessage) GetSeq() uint64 {
+	if m != nil {
+		return m.Seq
 	}
+	return 0
+}
 
-	that1, ok := that.(*ShareMessage_Init)
-	if !ok {
-		that2, ok := that.(ShareMessage_Init)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
+func (m *PingMessage) GetTime() int64 {
+	if m != nil {
+		return m.Time
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !this.Init.Equal(that1.Init) {
-		return false
-	}
-	return true
+	return 0
 }
-func (this *ShareMessage_Render) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *PingMessage) GetReply() bool {
+	if m != nil {
+		return m.Reply
 	}
+	return false
+}
 
-	that1, ok := that.(*ShareMessage_Render)
-	if !ok {
-		that2, ok := that.(ShareMessage_Render)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+type Glyph struct {
+	X        int32   `protobuf:"varint,1,opt,name=x,proto3" json:"x,omitempty"`
+	Y        int32   `protobuf:"varint,2,opt,name=y,proto3" json:"y,omitempty"`
+	Mainc    int32   `protobuf:"varint,3,opt,name=mainc,proto3" json:"mainc,omitempty"`
+	Combc    []int32 `protobuf:"varint,4,rep,packed,name=combc,proto3" json:"combc,omitempty"`
+	Fg       uint64  `protobuf:"varint,5,opt,name=fg,proto3" json:"fg,omitempty"`
+	Bg       uint64  `protobuf:"varint,6,opt,name=bg,proto3" json:"bg,omitempty"`
+	AttrMask int32   `protobuf:"varint,7,opt,name=attr_mask,json=attrMask,proto3" json:"attr_mask,omitempty"`
+	Width    int32   `protobuf:"varint,8,opt,name=width,proto3" json:"width,omitempty"`
+}
+
+func (m *Glyph) Reset()      { *m = Glyph{} }
+func (*Glyph) ProtoMessage() {}
+func (*Glyph) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce9e79630956d2f5, []int{12}
+}
+func (m *Glyph) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Glyph) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Glyph.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !this.Render.Equal(that1.Render) {
-		return false
-	}
-	return true
 }
-func (this *ShareMessage_Event) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+func (m *Glyph) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Glyph.Merge(m, src)
+}
+func (m *Glyph) XXX_Size() int {
+	return m.Size()
+}
+func (m *Glyph) XXX_DiscardUnknown() {
+	xxx_messageInfo_Glyph.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Glyph proto.InternalMessageInfo
+
+func (m *Glyph) GetX() int32 {
+	if m != nil {
+		return m.X
 	}
+	return 0
+}
 
-	that1, ok := that.(*ShareMessage_Event)
-	if !ok {
-		that2, ok := that.(ShareMessage_Event)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
+func (m *Glyph) GetY() int32 {
+	if m != nil {
+		return m.Y
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+	return 0
+}
+
+func (m *Glyph) GetMainc() int32 {
+	if m != nil {
+		return m.Mainc
 	}
-	if !this.Event.Equal(that1.Event) {
-		return false
+	return 0
+}
+
+func (m *Glyph) GetCombc() []int32 {
+	if m != nil {
+		return m.Combc
 	}
-	return true
+	return nil
 }
-func (this *InitMessage) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *Glyph) GetFg() uint64 {
+	if m != nil {
+		return m.Fg
 	}
+	return 0
+}
 
-	that1, ok := that.(*InitMessage)
-	if !ok {
-		that2, ok := that.(InitMessage)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
+func (m *Glyph) GetBg() uint64 {
+	if m != nil {
+		return m.Bg
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+	return 0
+}
+
+func (m *Glyph) GetAttrMask() int32 {
+	if m != nil {
+		return m.AttrMask
 	}
-	return true
+	return 0
 }
-func (this *RenderMessage) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *Glyph) GetWidth() int32 {
+	if m != nil {
+		return m.Width
 	}
+	return 0
+}
 
-	that1, ok := that.(*RenderMessage)
-	if !ok {
-		that2, ok := that.(RenderMessage)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+type EventMessage struct {
+	// Types that are valid to be assigned to Event:
+	//	*EventMessage_Mouse
+	//	*EventMessage_Key
+	//	*EventMessage_Resize
+	//	*EventMessage_Paste
+	//	*EventMessage_SelectPane
+	Event isEventMessage_Event `protobuf_oneof:"Event"`
+}
+
+func (m *EventMessage) Reset()      { *m = EventMessage{} }
+func (*EventMessage) ProtoMessage() {}
+func (*EventMessage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce9e79630956d2f5, []int{13}
+}
+func (m *EventMessage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventMessage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventMessage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.Cols != that1.Cols {
-		return false
-	}
-	if this.Rows != that1.Rows {
-		return false
-	}
-	if len(this.Glyphs) != len(that1.Glyphs) {
-		return false
+}
+func (m *EventMessage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMessage.Merge(m, src)
+}
+func (m *EventMessage) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventMessage) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMessage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventMessage proto.InternalMessageInfo
+
+type isEventMessage_Event interface {
+	isEventMessage_Event()
+	Equal(interface{}) bool
+	MarshalTo([]byte) (int, error)
+	Size() int
+}
+
+type EventMessage_Mouse struct {
+	Mouse *EventMouse `protobuf:"bytes,1,opt,name=Mouse,proto3,oneof" json:"Mouse,omitempty"`
+}
+type EventMessage_Key struct {
+	Key *EventKey `protobuf:"bytes,2,opt,name=Key,proto3,oneof" json:"Key,omitempty"`
+}
+type EventMessage_Resize struct {
+	Resize *EventResize `protobuf:"bytes,3,opt,name=Resize,proto3,oneof" json:"Resize,omitempty"`
+}
+type EventMessage_Paste struct {
+	Paste *EventPaste `protobuf:"bytes,4,opt,name=Paste,proto3,oneof" json:"Paste,omitempty"`
+}
+type EventMessage_SelectPane struct {
+	SelectPane *EventSelectPane `protobuf:"bytes,5,opt,name=SelectPane,proto3,oneof" json:"SelectPane,omitempty"`
+}
+
+func (*EventMessage_Mouse) isEventMessage_Event()      {}
+func (*EventMessage_Key) isEventMessage_Event()        {}
+func (*EventMessage_Resize) isEventMessage_Event()     {}
+func (*EventMessage_Paste) isEventMessage_Event()      {}
+func (*EventMessage_SelectPane) isEventMessage_Event() {}
+
+func (m *EventMessage) GetEvent() isEventMessage_Event {
+	if m != nil {
+		return m.Event
 	}
-	for i := range this.Glyphs {
-		if !this.Glyphs[i].Equal(that1.Glyphs[i]) {
-			return false
-		}
+	return nil
+}
+
+func (m *EventMessage) GetMouse() *EventMouse {
+	if x, ok := m.GetEvent().(*EventMessage_Mouse); ok {
+		return x.Mouse
 	}
-	return true
+	return nil
 }
-func (this *Glyph) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *EventMessage) GetKey() *EventKey {
+	if x, ok := m.GetEvent().(*EventMessage_Key); ok {
+		return x.Key
 	}
+	return nil
+}
 
-	that1, ok := that.(*Glyph)
-	if !ok {
-		that2, ok := that.(Glyph)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.X != that1.X {
-		return false
-	}
-	if this.Y != that1.Y {
-		return false
-	}
-	if this.Mainc != that1.Mainc {
-		return false
-	}
-	if len(this.Combc) != len(that1.Combc) {
-		return false
-	}
-	for i := range this.Combc {
-		if this.Combc[i] != that1.Combc[i] {
-			return false
-		}
-	}
-	if this.Fg != that1.Fg {
-		return false
-	}
-	if this.Bg != that1.Bg {
-		return false
-	}
-	if this.AttrMask != that1.AttrMask {
-		return false
-	}
-	if this.Width != that1.Width {
-		return false
+func (m *EventMessage) GetResize() *EventResize {
+	if x, ok := m.GetEvent().(*EventMessage_Resize); ok {
+		return x.Resize
 	}
-	return true
+	return nil
 }
-func (this *EventMessage) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
 
-	that1, ok := that.(*EventMessage)
-	if !ok {
-		that2, ok := that.(EventMessage)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+func (m *EventMessage) GetPaste() *EventPaste {
+	if x, ok := m.GetEvent().(*EventMessage_Paste); ok {
+		return x.Paste
 	}
-	if that1.Event == nil {
-		if this.Event != nil {
-			return false
-		}
-	} else if this.Event == nil {
-		return false
-	} else if !this.Event.Equal(that1.Event) {
-		return false
+	return nil
+}
+
+func (m *EventMessage) GetSelectPane() *EventSelectPane {
+	if x, ok := m.GetEvent().(*EventMessage_SelectPane); ok {
+		return x.SelectPane
 	}
-	return true
+	return nil
 }
-func (this *EventMessage_Mouse) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*EventMessage) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*EventMessage_Mouse)(nil),
+		(*EventMessage_Key)(nil),
+		(*EventMessage_Resize)(nil),
+		(*EventMessage_Paste)(nil),
+		(*EventMessage_SelectPane)(nil),
 	}
+}
 
-	that1, ok := that.(*EventMessage_Mouse)
-	if !ok {
-		that2, ok := that.(EventMessage_Mouse)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+type EventMouse struct {
+	X          int32 `protobuf:"varint,1,opt,name=x,proto3" json:"x,omitempty"`
+	Y          int32 `protobuf:"varint,2,opt,name=y,proto3" json:"y,omitempty"`
+	ButtonMask int32 `protobuf:"varint,3,opt,name=button_mask,json=buttonMask,proto3" json:"button_mask,omitempty"`
+	ModMask    int32 `protobuf:"varint,4,opt,name=mod_mask,json=modMask,proto3" json:"mod_mask,omitempty"`
+}
+
+func (m *EventMouse) Reset()      { *m = EventMouse{} }
+func (*EventMouse) ProtoMessage() {}
+func (*EventMouse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce9e79630956d2f5, []int{14}
+}
+func (m *EventMouse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventMouse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventMouse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !this.Mouse.Equal(that1.Mouse) {
-		return false
-	}
-	return true
 }
-func (this *EventMessage_Key) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+func (m *EventMouse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMouse.Merge(m, src)
+}
+func (m *EventMouse) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventMouse) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMouse.DiscardUnknown(m)
+}
 
-	that1, ok := that.(*EventMessage_Key)
-	if !ok {
-		that2, ok := that.(EventMessage_Key)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
+var xxx_messageInfo_EventMouse proto.InternalMessageInfo
+
+func (m *EventMouse) GetX() int32 {
+	if m != nil {
+		return m.X
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+	return 0
+}
+
+func (m *EventMouse) GetY() int32 {
+	if m != nil {
+		return m.Y
 	}
-	if !this.Key.Equal(that1.Key) {
-		return false
+	return 0
+}
+
+func (m *EventMouse) GetButtonMask() int32 {
+	if m != nil {
+		return m.ButtonMask
 	}
-	return true
+	return 0
 }
-func (this *EventMessage_Resize) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *EventMouse) GetModMask() int32 {
+	if m != nil {
+		return m.ModMask
 	}
+	return 0
+}
 
-	that1, ok := that.(*EventMessage_Resize)
-	if !ok {
-		that2, ok := that.(EventMessage_Resize)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+type EventKey struct {
+	Key     int32 `protobuf:"varint,1,opt,name=key,proto3" json:"key,omitempty"`
+	Rune    int32 `protobuf:"varint,2,opt,name=rune,proto3" json:"rune,omitempty"`
+	ModMask int32 `protobuf:"varint,3,opt,name=mod_mask,json=modMask,proto3" json:"mod_mask,omitempty"`
+}
+
+func (m *EventKey) Reset()      { *m = EventKey{} }
+func (*EventKey) ProtoMessage() {}
+func (*EventKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce9e79630956d2f5, []int{15}
+}
+func (m *EventKey) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventKey.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+}
+func (m *EventKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventKey.Merge(m, src)
+}
+func (m *EventKey) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventKey.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventKey proto.InternalMessageInfo
+
+func (m *EventKey) GetKey() int32 {
+	if m != nil {
+		return m.Key
 	}
-	if !this.Resize.Equal(that1.Resize) {
-		return false
+	return 0
+}
+
+func (m *EventKey) GetRune() int32 {
+	if m != nil {
+		return m.Rune
 	}
-	return true
+	return 0
 }
-func (this *EventMessage_Paste) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *EventKey) GetModMask() int32 {
+	if m != nil {
+		return m.ModMask
 	}
+	return 0
+}
 
-	that1, ok := that.(*EventMessage_Paste)
-	if !ok {
-		that2, ok := that.(EventMessage_Paste)
+type EventResize struct {
+	Width  int32 `protobuf:"varint,1,opt,name=width,proto3" json:"width,omitempty"`
+	Height int32 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *EventResize) Reset()      { *m = EventResize{} }
+func (*EventResize) ProtoMessage() {}
+func (*EventResize) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce9e79630956d2f5, []int{16}
+}
+func (m *EventResize) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventResize) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventResize.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventResize) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventResize.Merge(m, src)
+}
+func (m *EventResize) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventResize) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventResize.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventResize proto.InternalMessageInfo
+
+func (m *EventResize) GetWidth() int32 {
+	if m != nil {
+		return m.Width
+	}
+	return 0
+}
+
+func (m *EventResize) GetHeight() int32 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+type EventPaste struct {
+	Start bool `protobuf:"varint,1,opt,name=start,proto3" json:"start,omitempty"`
+}
+
+func (m *EventPaste) Reset()      { *m = EventPaste{} }
+func (*EventPaste) ProtoMessage() {}
+func (*EventPaste) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce9e79630956d2f5, []int{17}
+}
+func (m *EventPaste) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventPaste) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventPaste.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventPaste) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventPaste.Merge(m, src)
+}
+func (m *EventPaste) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventPaste) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventPaste.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventPaste proto.InternalMessageInfo
+
+func (m *EventPaste) GetStart() bool {
+	if m != nil {
+		return m.Start
+	}
+	return false
+}
+
+type EventSelectPane struct {
+	Pane int32 `protobuf:"varint,1,opt,name=pane,proto3" json:"pane,omitempty"`
+}
+
+func (m *EventSelectPane) Reset()      { *m = EventSelectPane{} }
+func (*EventSelectPane) ProtoMessage() {}
+func (*EventSelectPane) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce9e79630956d2f5, []int{18}
+}
+func (m *EventSelectPane) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventSelectPane) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventSelectPane.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventSelectPane) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventSelectPane.Merge(m, src)
+}
+func (m *EventSelectPane) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventSelectPane) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventSelectPane.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventSelectPane proto.InternalMessageInfo
+
+func (m *EventSelectPane) GetPane() int32 {
+	if m != nil {
+		return m.Pane
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*ShareMessage)(nil), "ptmux.rvt.v1.ShareMessage")
+	proto.RegisterType((*InitMessage)(nil), "ptmux.rvt.v1.InitMessage")
+	proto.RegisterType((*ExitMessage)(nil), "ptmux.rvt.v1.ExitMessage")
+	proto.RegisterType((*BellMessage)(nil), "ptmux.rvt.v1.BellMessage")
+	proto.RegisterType((*ClipboardMessage)(nil), "ptmux.rvt.v1.ClipboardMessage")
+	proto.RegisterType((*LeaveMessage)(nil), "ptmux.rvt.v1.LeaveMessage")
+	proto.RegisterType((*RenderMessage)(nil), "ptmux.rvt.v1.RenderMessage")
+	proto.RegisterType((*Cursor)(nil), "ptmux.rvt.v1.Cursor")
+	proto.RegisterType((*Style)(nil), "ptmux.rvt.v1.Style")
+	proto.RegisterType((*Run)(nil), "ptmux.rvt.v1.Run")
+	proto.RegisterType((*AckMessage)(nil), "ptmux.rvt.v1.AckMessage")
+	proto.RegisterType((*PingMessage)(nil), "ptmux.rvt.v1.PingMessage")
+	proto.RegisterType((*Glyph)(nil), "ptmux.rvt.v1.Glyph")
+	proto.RegisterType((*EventMessage)(nil), "ptmux.rvt.v1.EventMessage")
+	proto.RegisterType((*EventMouse)(nil), "ptmux.rvt.v1.EventMouse")
+	proto.RegisterType((*EventKey)(nil), "ptmux.rvt.v1.EventKey")
+	proto.RegisterType((*EventResize)(nil), "ptmux.rvt.v1.EventResize")
+	proto.RegisterType((*EventPaste)(nil), "ptmux.rvt.v1.EventPaste")
+	proto.RegisterType((*EventSelectPane)(nil), "ptmux.rvt.v1.EventSelectPane")
+}
+
+func init() { proto.RegisterFile("rvt.proto", fileDescriptor_ce9e79630956d2f5) }
+
+var fileDescriptor_ce9e79630956d2f5 = []byte{
+	// 1053 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x56, 0x4f, 0x8f, 0xdb, 0x44,
+	0x14, 0xcf, 0xc4, 0x71, 0xfe, 0xbc, 0xa4, 0x50, 0x86, 0x55, 0xe5, 0x6e, 0x85, 0xbb, 0x58, 0xaa,
+	0xb4, 0x82, 0x6a, 0x29, 0x5b, 0x71, 0x00, 0xa4, 0xa2, 0x6e, 0x59, 0xd1, 0x6a, 0x59, 0x69, 0x35,
+	0x2b, 0x71, 0xe0, 0x52, 0x39, 0xf6, 0x6c, 0x62, 0xc5, 0xb1, 0x83, 0x3d, 0x49, 0x13, 0x4e, 0x7c,
+	0x04, 0xbe, 0x00, 0x17, 0x4e, 0x7c, 0x06, 0x3e, 0x01, 0xc7, 0x3d, 0xf6, 0xc8, 0x66, 0x2f, 0x1c,
+	0x7b, 0x46, 0x1c, 0xd0, 0x7b, 0x33, 0x89, 0x9d, 0x60, 0xb6, 0xb7, 0xf7, 0x9b, 0xf7, 0x7e, 0xe3,
+	0xdf, 0xbc, 0xf7, 0xe6, 0x8d, 0xa1, 0x93, 0xcd, 0xd4, 0xc1, 0x24, 0x4b, 0x55, 0xca, 0x7b, 0x13,
+	0x35, 0x9e, 0xce, 0x0f, 0x70, 0x61, 0xf6, 0xa9, 0xf7, 0xb7, 0x05, 0xbd, 0xf3, 0xa1, 0x9f, 0xc9,
+	0x53, 0x99, 0xe7, 0xfe, 0x40, 0xf2, 0x77, 0xa0, 0x1e, 0x85, 0x0e, 0xdb, 0x63, 0xfb, 0x1d, 0x51,
+	0x8f, 0x42, 0xfe, 0x09, 0x34, 0x5e, 0x24, 0x91, 0x72, 0xea, 0x7b, 0x6c, 0xbf, 0x7b, 0x78, 0xf7,
+	0xa0, 0xcc, 0x3e, 0x40, 0x8f, 0x21, 0x3e, 0xaf, 0x09, 0x0a, 0xe4, 0x9f, 0x41, 0x53, 0xc8, 0x24,
+	0x94, 0x99, 0x63, 0x11, 0xe5, 0xde, 0x26, 0x45, 0xfb, 0x0a, 0x92, 0x09, 0xe6, 0x87, 0x60, 0x1f,
+	0xcf, 0x64, 0xa2, 0x9c, 0x06, 0xb1, 0x76, 0x37, 0x59, 0xe4, 0x2a, 0x48, 0x3a, 0x14, 0xb5, 0x1d,
+	0xcf, 0x23, 0xe5, 0xd8, 0x55, 0xda, 0xd0, 0x53, 0xd2, 0x86, 0x10, 0x09, 0x47, 0x32, 0x8e, 0x9d,
+	0x66, 0x15, 0x01, 0x3d, 0x25, 0x02, 0x42, 0x54, 0xf5, 0xad, 0xf4, 0x67, 0xd2, 0x69, 0x55, 0xa9,
+	0x22, 0x57, 0x49, 0x15, 0x61, 0xfe, 0x10, 0xac, 0xa7, 0xc1, 0xc8, 0x69, 0x13, 0xc3, 0xd9, 0x64,
+	0x3c, 0x0d, 0x46, 0x45, 0x3c, 0x86, 0xa1, 0xa4, 0xb3, 0x28, 0x19, 0x38, 0x9d, 0x2a, 0x49, 0xe8,
+	0x29, 0x49, 0x42, 0xc8, 0x9f, 0x40, 0xe7, 0x59, 0x1c, 0x4d, 0xfa, 0xa9, 0x9f, 0x85, 0x0e, 0x10,
+	0xcb, 0xdd, 0x64, 0xad, 0xdd, 0x05, 0xb5, 0xa0, 0x1c, 0x75, 0xa0, 0x65, 0xd6, 0xbd, 0xdf, 0x19,
+	0x74, 0x4b, 0x25, 0xe4, 0x3b, 0x60, 0xab, 0x74, 0x24, 0x13, 0x53, 0x7e, 0x0d, 0xb8, 0x03, 0xad,
+	0x99, 0xcc, 0xf2, 0x28, 0x4d, 0xa8, 0x09, 0x6e, 0x89, 0x15, 0xe4, 0xbb, 0xd0, 0xbe, 0x90, 0xbe,
+	0x9a, 0x66, 0x32, 0x77, 0xac, 0x3d, 0x6b, 0xbf, 0x23, 0xd6, 0x98, 0x73, 0x68, 0x04, 0x69, 0x9c,
+	0x53, 0x39, 0x6d, 0x41, 0x36, 0xae, 0x65, 0xe9, 0xab, 0x9c, 0xea, 0x65, 0x0b, 0xb2, 0xf9, 0x1d,
+	0x68, 0x06, 0x69, 0x9c, 0x66, 0x39, 0x15, 0xc5, 0x16, 0x06, 0xf1, 0xfb, 0xd0, 0x1d, 0x47, 0xc9,
+	0xcb, 0xd5, 0x97, 0x5b, 0xf4, 0x65, 0x18, 0x47, 0xc9, 0x77, 0x7a, 0xc5, 0x0b, 0xa0, 0x7b, 0x3c,
+	0xdf, 0xd4, 0x1e, 0xa9, 0x58, 0xae, 0xb5, 0x23, 0xd0, 0x2a, 0x42, 0xe9, 0xd4, 0x57, 0x2a, 0x42,
+	0x89, 0x5f, 0xcc, 0xa3, 0x41, 0xe2, 0xc7, 0xd4, 0xa0, 0x1d, 0x61, 0x10, 0xc6, 0x4e, 0xfc, 0x44,
+	0xae, 0x14, 0xa3, 0xed, 0x7d, 0x08, 0xdd, 0x52, 0x5b, 0xac, 0x43, 0x58, 0x29, 0xe4, 0x0b, 0xb8,
+	0xbd, 0x9d, 0xf0, 0xaa, 0x38, 0x5c, 0x0b, 0x7d, 0xe5, 0x93, 0x94, 0x9e, 0x20, 0xdb, 0xf3, 0xa0,
+	0x57, 0xee, 0x21, 0xe2, 0x49, 0x99, 0x99, 0x33, 0x90, 0xed, 0xfd, 0xc3, 0xe0, 0xd6, 0xc6, 0xa5,
+	0x59, 0xa7, 0x96, 0x55, 0xa4, 0xb6, 0x5e, 0x4a, 0xed, 0xc7, 0xd0, 0x1c, 0xc4, 0x8b, 0xc9, 0x50,
+	0x17, 0xa7, 0x7b, 0xf8, 0xfe, 0x66, 0x9b, 0x7c, 0x83, 0x3e, 0x61, 0x42, 0x30, 0x7f, 0x17, 0x99,
+	0x3f, 0xd6, 0xc7, 0x6f, 0x08, 0x0d, 0x70, 0x8b, 0x5c, 0x2d, 0x62, 0x89, 0x35, 0xab, 0xd8, 0xe2,
+	0x1c, 0x7d, 0xc2, 0x84, 0xf0, 0x07, 0xd0, 0xc8, 0xa6, 0x09, 0x16, 0x12, 0x43, 0xdf, 0xdb, 0xba,
+	0xf7, 0xd3, 0x44, 0x90, 0x9b, 0x3f, 0x84, 0x66, 0x30, 0xcd, 0xf2, 0x34, 0x33, 0x97, 0x6a, 0x67,
+	0xab, 0x7b, 0xc9, 0x27, 0x4c, 0x8c, 0xf7, 0x04, 0x9a, 0x7a, 0x85, 0xf7, 0x80, 0xcd, 0xcd, 0x99,
+	0xd9, 0x1c, 0xd1, 0xc2, 0x9c, 0x96, 0x2d, 0xb0, 0x47, 0x27, 0x99, 0x0c, 0xa3, 0x40, 0x51, 0x51,
+	0xdb, 0x62, 0x05, 0xbd, 0xaf, 0xc1, 0x26, 0x95, 0x38, 0xd8, 0x2e, 0x06, 0xc4, 0x6f, 0x88, 0xfa,
+	0xc5, 0x00, 0x71, 0x7f, 0x40, 0x3b, 0x34, 0x44, 0xbd, 0x3f, 0xe0, 0xf7, 0xa0, 0xe3, 0x2b, 0x95,
+	0xbd, 0x1c, 0xfb, 0xf9, 0x88, 0x36, 0xb1, 0x45, 0x1b, 0x17, 0x4e, 0xfd, 0x7c, 0xe4, 0xf9, 0x60,
+	0x89, 0x69, 0x72, 0xa3, 0x84, 0x1d, 0xb0, 0x29, 0x0f, 0x86, 0xab, 0x01, 0xd6, 0x45, 0xc9, 0xb9,
+	0x9e, 0x6a, 0x1d, 0x41, 0x36, 0x46, 0x06, 0xe9, 0xb8, 0x1f, 0x50, 0x4e, 0x6d, 0xa1, 0x81, 0xe7,
+	0x01, 0x14, 0xd3, 0xa1, 0x28, 0x07, 0x2b, 0x95, 0xc3, 0x7b, 0x01, 0xdd, 0xd2, 0x48, 0xe0, 0xb7,
+	0xc1, 0xca, 0xe5, 0x0f, 0x26, 0x04, 0x4d, 0xfa, 0x5c, 0x34, 0xd6, 0xfd, 0x6e, 0x09, 0xb2, 0x71,
+	0xab, 0x4c, 0x4e, 0xe2, 0x85, 0xc9, 0x8c, 0x06, 0xde, 0xaf, 0x0c, 0x6c, 0xea, 0x80, 0xb7, 0x1d,
+	0x6a, 0xec, 0x47, 0x49, 0xb0, 0x3a, 0x14, 0x81, 0xe2, 0x00, 0x8d, 0xd2, 0x01, 0x4c, 0x82, 0xed,
+	0xad, 0x04, 0x37, 0xab, 0x13, 0xdc, 0xda, 0x4c, 0x30, 0x6e, 0xf9, 0x2a, 0x0a, 0xd5, 0x90, 0xc6,
+	0xa6, 0x2d, 0x34, 0xf0, 0x7e, 0xa9, 0x43, 0xaf, 0x3c, 0xfa, 0xf9, 0x23, 0xb0, 0x4f, 0xd3, 0x69,
+	0xae, 0xd3, 0xf2, 0x9f, 0xe9, 0xaa, 0x43, 0xd1, 0x8f, 0xd3, 0x98, 0x0c, 0xfe, 0x11, 0x58, 0x27,
+	0x72, 0x61, 0x9e, 0xaf, 0x3b, 0x15, 0xf1, 0x27, 0x72, 0x81, 0xb3, 0xf8, 0x44, 0x2e, 0xf8, 0x63,
+	0x7c, 0xba, 0xf2, 0xe8, 0x47, 0xe9, 0x58, 0x55, 0xd3, 0x98, 0xc2, 0x75, 0x80, 0x7e, 0xb8, 0xd0,
+	0x42, 0x49, 0x67, 0x7e, 0xae, 0xa4, 0xd3, 0xf8, 0x5f, 0x49, 0xe4, 0x47, 0x49, 0x64, 0xf0, 0xaf,
+	0x00, 0xce, 0x65, 0x2c, 0x03, 0x75, 0x86, 0x33, 0x42, 0x3f, 0x5e, 0x1f, 0x54, 0xd0, 0x8a, 0xa0,
+	0xe7, 0x35, 0x51, 0xa2, 0x1c, 0xb5, 0xcc, 0x5b, 0xe9, 0x85, 0x00, 0xc5, 0x99, 0x6f, 0x2c, 0xe4,
+	0x7d, 0xe8, 0xf6, 0xa7, 0x4a, 0xa5, 0x49, 0xb9, 0xbf, 0x41, 0x2f, 0x51, 0x01, 0xee, 0x42, 0x7b,
+	0x9c, 0x86, 0xda, 0xab, 0x27, 0x60, 0x6b, 0x9c, 0x86, 0xd4, 0xfc, 0x27, 0xd0, 0x5e, 0x65, 0x0a,
+	0x5b, 0x6e, 0x24, 0x17, 0xe6, 0x2b, 0x68, 0xd2, 0xe4, 0x99, 0x26, 0xeb, 0x11, 0x8b, 0xf6, 0xc6,
+	0x66, 0xd6, 0xe6, 0x66, 0x5f, 0x42, 0xb7, 0x94, 0xc7, 0xa2, 0xee, 0xac, 0x54, 0x77, 0x1c, 0xd1,
+	0x43, 0x19, 0x0d, 0x86, 0xca, 0xec, 0x6a, 0x10, 0xde, 0x91, 0x22, 0xa1, 0xfa, 0xc6, 0xf9, 0x99,
+	0x22, 0x6e, 0x5b, 0x68, 0xe0, 0x3d, 0x80, 0x77, 0xb7, 0xb2, 0x57, 0x35, 0x8e, 0x0f, 0x4f, 0xa1,
+	0x79, 0x1e, 0x64, 0x52, 0x26, 0xfc, 0x19, 0xd8, 0xf4, 0x07, 0xc4, 0xb7, 0x5e, 0xf7, 0xf2, 0x6f,
+	0xd1, 0xee, 0x0d, 0xbe, 0x7d, 0xf6, 0x88, 0x1d, 0x7d, 0x7e, 0x79, 0xe5, 0xd6, 0x5e, 0x5f, 0xb9,
+	0xb5, 0x37, 0x57, 0x2e, 0xfb, 0x69, 0xe9, 0xb2, 0xdf, 0x96, 0x2e, 0xfb, 0x63, 0xe9, 0xb2, 0xcb,
+	0xa5, 0xcb, 0xfe, 0x5c, 0xba, 0xec, 0xaf, 0xa5, 0x5b, 0x7b, 0xb3, 0x74, 0xd9, 0xcf, 0xd7, 0x6e,
+	0xed, 0xf2, 0xda, 0xad, 0xbd, 0xbe, 0x76, 0x6b, 0xdf, 0x5b, 0xd9, 0x4c, 0xf5, 0x9b, 0xf4, 0x5f,
+	0xf6, 0xf8, 0xdf, 0x01, 0x00, 0x3a, 0x17, 0x83, 0xd3, 0xa4, 0x09, 0x00, 0x00,
+}
+
+func (this *ShareMessage) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ShareMessage)
+	if !ok {
+		that2, ok := that.(ShareMessage)
 		if ok {
 			that1 = &that2
 		} else {
@@ -1046,19 +1526,28 @@ func (this *EventMessage_Paste) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.Paste.Equal(that1.Paste) {
+	if this.Id != that1.Id {
+		return false
+	}
+	if that1.Message == nil {
+		if this.Message != nil {
+			return false
+		}
+	} else if this.Message == nil {
+		return false
+	} else if !this.Message.Equal(that1.Message) {
 		return false
 	}
 	return true
 }
-func (this *EventMouse) Equal(that interface{}) bool {
+func (this *ShareMessage_Init) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*EventMouse)
+	that1, ok := that.(*ShareMessage_Init)
 	if !ok {
-		that2, ok := that.(EventMouse)
+		that2, ok := that.(ShareMessage_Init)
 		if ok {
 			that1 = &that2
 		} else {
@@ -1070,28 +1559,43 @@ func (this *EventMouse) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.X != that1.X {
+	if !this.Init.Equal(that1.Init) {
 		return false
 	}
-	if this.Y != that1.Y {
-		return false
+	return true
+}
+func (this *ShareMessage_Render) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	if this.ButtonMask != that1.ButtonMask {
+
+	that1, ok := that.(*ShareMessage_Render)
+	if !ok {
+		that2, ok := that.(ShareMessage_Render)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
 		return false
 	}
-	if this.ModMask != that1.ModMask {
+	if !this.Render.Equal(that1.Render) {
 		return false
 	}
 	return true
 }
-func (this *EventKey) Equal(that interface{}) bool {
+func (this *ShareMessage_Event) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*EventKey)
+	that1, ok := that.(*ShareMessage_Event)
 	if !ok {
-		that2, ok := that.(EventKey)
+		that2, ok := that.(ShareMessage_Event)
 		if ok {
 			that1 = &that2
 		} else {
@@ -1103,25 +1607,43 @@ func (this *EventKey) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.Key != that1.Key {
+	if !this.Event.Equal(that1.Event) {
 		return false
 	}
-	if this.Rune != that1.Rune {
+	return true
+}
+func (this *ShareMessage_Exit) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ShareMessage_Exit)
+	if !ok {
+		that2, ok := that.(ShareMessage_Exit)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
 		return false
 	}
-	if this.ModMask != that1.ModMask {
+	if !this.Exit.Equal(that1.Exit) {
 		return false
 	}
 	return true
 }
-func (this *EventResize) Equal(that interface{}) bool {
+func (this *ShareMessage_Bell) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*EventResize)
+	that1, ok := that.(*ShareMessage_Bell)
 	if !ok {
-		that2, ok := that.(EventResize)
+		that2, ok := that.(ShareMessage_Bell)
 		if ok {
 			that1 = &that2
 		} else {
@@ -1133,22 +1655,19 @@ func (this *EventResize) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.Width != that1.Width {
-		return false
-	}
-	if this.Height != that1.Height {
+	if !this.Bell.Equal(that1.Bell) {
 		return false
 	}
 	return true
 }
-func (this *EventPaste) Equal(that interface{}) bool {
+func (this *ShareMessage_Leave) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*EventPaste)
+	that1, ok := that.(*ShareMessage_Leave)
 	if !ok {
-		that2, ok := that.(EventPaste)
+		that2, ok := that.(ShareMessage_Leave)
 		if ok {
 			that1 = &that2
 		} else {
@@ -1160,1262 +1679,4604 @@ func (this *EventPaste) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.Start != that1.Start {
+	if !this.Leave.Equal(that1.Leave) {
 		return false
 	}
 	return true
 }
-func (this *ShareMessage) GoString() string {
-	if this == nil {
-		return "nil"
+func (this *ShareMessage_Ack) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	s := make([]string, 0, 8)
-	s = append(s, "&rvt.ShareMessage{")
-	s = append(s, "Id: "+fmt.Sprintf("%#v", this.Id)+",\n")
-	if this.Message != nil {
-		s = append(s, "Message: "+fmt.Sprintf("%#v", this.Message)+",\n")
+
+	that1, ok := that.(*ShareMessage_Ack)
+	if !ok {
+		that2, ok := that.(ShareMessage_Ack)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *ShareMessage_Init) GoString() string {
-	if this == nil {
-		return "nil"
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	s := strings.Join([]string{`&rvt.ShareMessage_Init{` +
-		`Init:` + fmt.Sprintf("%#v", this.Init) + `}`}, ", ")
-	return s
+	if !this.Ack.Equal(that1.Ack) {
+		return false
+	}
+	return true
 }
-func (this *ShareMessage_Render) GoString() string {
-	if this == nil {
-		return "nil"
+func (this *ShareMessage_Ping) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	s := strings.Join([]string{`&rvt.ShareMessage_Render{` +
-		`Render:` + fmt.Sprintf("%#v", this.Render) + `}`}, ", ")
-	return s
-}
-func (this *ShareMessage_Event) GoString() string {
-	if this == nil {
-		return "nil"
+
+	that1, ok := that.(*ShareMessage_Ping)
+	if !ok {
+		that2, ok := that.(ShareMessage_Ping)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	s := strings.Join([]string{`&rvt.ShareMessage_Event{` +
-		`Event:` + fmt.Sprintf("%#v", this.Event) + `}`}, ", ")
-	return s
-}
-func (this *InitMessage) GoString() string {
-	if this == nil {
-		return "nil"
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	s := make([]string, 0, 4)
-	s = append(s, "&rvt.InitMessage{")
-	s = append(s, "}")
-	return strings.Join(s, "")
+	if !this.Ping.Equal(that1.Ping) {
+		return false
+	}
+	return true
 }
-func (this *RenderMessage) GoString() string {
-	if this == nil {
-		return "nil"
+func (this *ShareMessage_Clipboard) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	s := make([]string, 0, 7)
-	s = append(s, "&rvt.RenderMessage{")
-	s = append(s, "Cols: "+fmt.Sprintf("%#v", this.Cols)+",\n")
-	s = append(s, "Rows: "+fmt.Sprintf("%#v", this.Rows)+",\n")
-	if this.Glyphs != nil {
-		s = append(s, "Glyphs: "+fmt.Sprintf("%#v", this.Glyphs)+",\n")
+
+	that1, ok := that.(*ShareMessage_Clipboard)
+	if !ok {
+		that2, ok := that.(ShareMessage_Clipboard)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *Glyph) GoString() string {
-	if this == nil {
-		return "nil"
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	s := make([]string, 0, 12)
-	s = append(s, "&rvt.Glyph{")
-	s = append(s, "X: "+fmt.Sprintf("%#v", this.X)+",\n")
-	s = append(s, "Y: "+fmt.Sprintf("%#v", this.Y)+",\n")
-	s = append(s, "Mainc: "+fmt.Sprintf("%#v", this.Mainc)+",\n")
-	s = append(s, "Combc: "+fmt.Sprintf("%#v", this.Combc)+",\n")
-	s = append(s, "Fg: "+fmt.Sprintf("%#v", this.Fg)+",\n")
-	s = append(s, "Bg: "+fmt.Sprintf("%#v", this.Bg)+",\n")
-	s = append(s, "AttrMask: "+fmt.Sprintf("%#v", this.AttrMask)+",\n")
-	s = append(s, "Width: "+fmt.Sprintf("%#v", this.Width)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
+	if !this.Clipboard.Equal(that1.Clipboard) {
+		return false
+	}
+	return true
 }
-func (this *EventMessage) GoString() string {
-	if this == nil {
-		return "nil"
+func (this *InitMessage) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	s := make([]string, 0, 8)
-	s = append(s, "&rvt.EventMessage{")
-	if this.Event != nil {
-		s = append(s, "Event: "+fmt.Sprintf("%#v", this.Event)+",\n")
+
+	that1, ok := that.(*InitMessage)
+	if !ok {
+		that2, ok := that.(InitMessage)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *EventMessage_Mouse) GoString() string {
-	if this == nil {
-		return "nil"
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	s := strings.Join([]string{`&rvt.EventMessage_Mouse{` +
-		`Mouse:` + fmt.Sprintf("%#v", this.Mouse) + `}`}, ", ")
-	return s
-}
-func (this *EventMessage_Key) GoString() string {
-	if this == nil {
-		return "nil"
+	if this.Token != that1.Token {
+		return false
 	}
-	s := strings.Join([]string{`&rvt.EventMessage_Key{` +
-		`Key:` + fmt.Sprintf("%#v", this.Key) + `}`}, ", ")
-	return s
-}
-func (this *EventMessage_Resize) GoString() string {
-	if this == nil {
-		return "nil"
+	if this.Version != that1.Version {
+		return false
 	}
-	s := strings.Join([]string{`&rvt.EventMessage_Resize{` +
-		`Resize:` + fmt.Sprintf("%#v", this.Resize) + `}`}, ", ")
-	return s
-}
-func (this *EventMessage_Paste) GoString() string {
-	if this == nil {
-		return "nil"
+	if len(this.Features) != len(that1.Features) {
+		return false
 	}
-	s := strings.Join([]string{`&rvt.EventMessage_Paste{` +
-		`Paste:` + fmt.Sprintf("%#v", this.Paste) + `}`}, ", ")
-	return s
-}
-func (this *EventMouse) GoString() string {
-	if this == nil {
-		return "nil"
+	for i := range this.Features {
+		if this.Features[i] != that1.Features[i] {
+			return false
+		}
 	}
-	s := make([]string, 0, 8)
-	s = append(s, "&rvt.EventMouse{")
-	s = append(s, "X: "+fmt.Sprintf("%#v", this.X)+",\n")
-	s = append(s, "Y: "+fmt.Sprintf("%#v", this.Y)+",\n")
-	s = append(s, "ButtonMask: "+fmt.Sprintf("%#v", this.ButtonMask)+",\n")
-	s = append(s, "ModMask: "+fmt.Sprintf("%#v", this.ModMask)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *EventKey) GoString() string {
-	if this == nil {
-		return "nil"
+	if this.Cols != that1.Cols {
+		return false
 	}
-	s := make([]string, 0, 7)
-	s = append(s, "&rvt.EventKey{")
-	s = append(s, "Key: "+fmt.Sprintf("%#v", this.Key)+",\n")
-	s = append(s, "Rune: "+fmt.Sprintf("%#v", this.Rune)+",\n")
-	s = append(s, "ModMask: "+fmt.Sprintf("%#v", this.ModMask)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *EventResize) GoString() string {
-	if this == nil {
-		return "nil"
+	if this.Rows != that1.Rows {
+		return false
 	}
-	s := make([]string, 0, 6)
-	s = append(s, "&rvt.EventResize{")
-	s = append(s, "Width: "+fmt.Sprintf("%#v", this.Width)+",\n")
-	s = append(s, "Height: "+fmt.Sprintf("%#v", this.Height)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *EventPaste) GoString() string {
-	if this == nil {
-		return "nil"
+	if this.Colors != that1.Colors {
+		return false
 	}
-	s := make([]string, 0, 5)
-	s = append(s, "&rvt.EventPaste{")
-	s = append(s, "Start: "+fmt.Sprintf("%#v", this.Start)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func valueToGoStringRvt(v interface{}, typ string) string {
-	rv := reflect.ValueOf(v)
-	if rv.IsNil() {
-		return "nil"
+	if this.MinVersion != that1.MinVersion {
+		return false
 	}
-	pv := reflect.Indirect(rv).Interface()
-	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
-}
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// ScreenClient is the client API for Screen service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type ScreenClient interface {
-	Share(ctx context.Context, opts ...grpc.CallOption) (Screen_ShareClient, error)
+	return true
 }
+func (this *ExitMessage) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-type screenClient struct {
-	cc *grpc.ClientConn
+	that1, ok := that.(*ExitMessage)
+	if !ok {
+		that2, ok := that.(ExitMessage)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Title != that1.Title {
+		return false
+	}
+	if this.Code != that1.Code {
+		return false
+	}
+	if this.Signal != that1.Signal {
+		return false
+	}
+	if this.Pane != that1.Pane {
+		return false
+	}
+	return true
 }
+func (this *BellMessage) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func NewScreenClient(cc *grpc.ClientConn) ScreenClient {
-	return &screenClient{cc}
+	that1, ok := that.(*BellMessage)
+	if !ok {
+		that2, ok := that.(BellMessage)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Pane != that1.Pane {
+		return false
+	}
+	return true
 }
+func (this *ClipboardMessage) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (c *screenClient) Share(ctx context.Context, opts ...grpc.CallOption) (Screen_ShareClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_Screen_serviceDesc.Streams[0], "/ptmux.rvt.v1.Screen/Share", opts...)
-	if err != nil {
-		return nil, err
+	that1, ok := that.(*ClipboardMessage)
+	if !ok {
+		that2, ok := that.(ClipboardMessage)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	x := &screenShareClient{stream}
-	return x, nil
-}
-
-type Screen_ShareClient interface {
-	Send(*ShareMessage) error
-	Recv() (*ShareMessage, error)
-	grpc.ClientStream
-}
-
-type screenShareClient struct {
-	grpc.ClientStream
-}
-
-func (x *screenShareClient) Send(m *ShareMessage) error {
-	return x.ClientStream.SendMsg(m)
-}
-
-func (x *screenShareClient) Recv() (*ShareMessage, error) {
-	m := new(ShareMessage)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return m, nil
-}
-
-// ScreenServer is the server API for Screen service.
-type ScreenServer interface {
-	Share(Screen_ShareServer) error
-}
-
-// UnimplementedScreenServer can be embedded to have forward compatible implementations.
-type UnimplementedScreenServer struct {
-}
-
-func (*UnimplementedScreenServer) Share(srv Screen_ShareServer) error {
-	return status.Errorf(codes.Unimplemented, "method Share not implemented")
-}
-
-func RegisterScreenServer(s *grpc.Server, srv ScreenServer) {
-	s.RegisterService(&_Screen_serviceDesc, srv)
-}
-
-func _Screen_Share_Handler(srv interface{}, stream grpc.ServerStream) error {
-	return srv.(ScreenServer).Share(&screenShareServer{stream})
-}
-
-type Screen_ShareServer interface {
-	Send(*ShareMessage) error
-	Recv() (*ShareMessage, error)
-	grpc.ServerStream
-}
-
-type screenShareServer struct {
-	grpc.ServerStream
-}
-
-func (x *screenShareServer) Send(m *ShareMessage) error {
-	return x.ServerStream.SendMsg(m)
-}
-
-func (x *screenShareServer) Recv() (*ShareMessage, error) {
-	m := new(ShareMessage)
-	if err := x.ServerStream.RecvMsg(m); err != nil {
-		return nil, err
+	if this.Pane != that1.Pane {
+		return false
 	}
-	return m, nil
-}
-
-var _Screen_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "ptmux.rvt.v1.Screen",
-	HandlerType: (*ScreenServer)(nil),
-	Methods:     []grpc.MethodDesc{},
-	Streams: []grpc.StreamDesc{
-		{
-			StreamName:    "Share",
-			Handler:       _Screen_Share_Handler,
-			ServerStreams: true,
-			ClientStreams: true,
-		},
-	},
-	Metadata: "rvt.proto",
-}
-
-func (m *ShareMessage) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if !bytes.Equal(this.Data, that1.Data) {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *ShareMessage) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return true
 }
+func (this *LeaveMessage) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *ShareMessage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Message != nil {
-		{
-			size := m.Message.Size()
-			i -= size
-			if _, err := m.Message.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
+	that1, ok := that.(*LeaveMessage)
+	if !ok {
+		that2, ok := that.(LeaveMessage)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
 	}
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintRvt(dAtA, i, uint64(len(m.Id)))
-		i--
-		dAtA[i] = 0xa
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *ShareMessage_Init) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if this.Peer != that1.Peer {
+		return false
+	}
+	return true
 }
+func (this *RenderMessage) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *ShareMessage_Init) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Init != nil {
-		{
-			size, err := m.Init.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintRvt(dAtA, i, uint64(size))
+	that1, ok := that.(*RenderMessage)
+	if !ok {
+		that2, ok := that.(RenderMessage)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i--
-		dAtA[i] = 0x12
 	}
-	return len(dAtA) - i, nil
-}
-func (m *ShareMessage_Render) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *ShareMessage_Render) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Render != nil {
-		{
-			size, err := m.Render.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintRvt(dAtA, i, uint64(size))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Cols != that1.Cols {
+		return false
+	}
+	if this.Rows != that1.Rows {
+		return false
+	}
+	if len(this.Glyphs) != len(that1.Glyphs) {
+		return false
+	}
+	for i := range this.Glyphs {
+		if !this.Glyphs[i].Equal(that1.Glyphs[i]) {
+			return false
 		}
-		i--
-		dAtA[i] = 0x1a
 	}
-	return len(dAtA) - i, nil
-}
-func (m *ShareMessage_Event) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *ShareMessage_Event) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Event != nil {
-		{
-			size, err := m.Event.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintRvt(dAtA, i, uint64(size))
+	if this.Frame != that1.Frame {
+		return false
+	}
+	if len(this.Styles) != len(that1.Styles) {
+		return false
+	}
+	for i := range this.Styles {
+		if !this.Styles[i].Equal(that1.Styles[i]) {
+			return false
 		}
-		i--
-		dAtA[i] = 0x22
 	}
-	return len(dAtA) - i, nil
-}
-func (m *InitMessage) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if len(this.Runs) != len(that1.Runs) {
+		return false
 	}
-	return dAtA[:n], nil
+	for i := range this.Runs {
+		if !this.Runs[i].Equal(that1.Runs[i]) {
+			return false
+		}
+	}
+	if !this.Cursor.Equal(that1.Cursor) {
+		return false
+	}
+	return true
 }
+func (this *Cursor) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *InitMessage) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *InitMessage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
-}
-
-func (m *RenderMessage) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	that1, ok := that.(*Cursor)
+	if !ok {
+		that2, ok := that.(Cursor)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	return dAtA[:n], nil
-}
-
-func (m *RenderMessage) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.X != that1.X {
+		return false
+	}
+	if this.Y != that1.Y {
+		return false
+	}
+	if this.Predict != that1.Predict {
+		return false
+	}
+	return true
 }
+func (this *Style) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *RenderMessage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Glyphs) > 0 {
-		for iNdEx := len(m.Glyphs) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Glyphs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintRvt(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
+	that1, ok := that.(*Style)
+	if !ok {
+		that2, ok := that.(Style)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
 	}
-	if m.Rows != 0 {
-		i = encodeVarintRvt(dAtA, i, uint64(m.Rows))
-		i--
-		dAtA[i] = 0x10
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if m.Cols != 0 {
-		i = encodeVarintRvt(dAtA, i, uint64(m.Cols))
-		i--
-		dAtA[i] = 0x8
+	if this.Fg != that1.Fg {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *Glyph) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if this.Bg != that1.Bg {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *Glyph) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if this.AttrMask != that1.AttrMask {
+		return false
+	}
+	return true
 }
+func (this *Run) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *Glyph) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Width != 0 {
-		i = encodeVarintRvt(dAtA, i, uint64(m.Width))
-		i--
-		dAtA[i] = 0x40
+	that1, ok := that.(*Run)
+	if !ok {
+		that2, ok := that.(Run)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if m.AttrMask != 0 {
-		i = encodeVarintRvt(dAtA, i, uint64(m.AttrMask))
-		i--
-		dAtA[i] = 0x38
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if m.Bg != 0 {
-		i = encodeVarintRvt(dAtA, i, uint64(m.Bg))
-		i--
-		dAtA[i] = 0x30
+	if this.X != that1.X {
+		return false
 	}
-	if m.Fg != 0 {
-		i = encodeVarintRvt(dAtA, i, uint64(m.Fg))
-		i--
-		dAtA[i] = 0x28
+	if this.Y != that1.Y {
+		return false
 	}
-	if len(m.Combc) > 0 {
-		dAtA5 := make([]byte, len(m.Combc)*10)
-		var j4 int
-		for _, num1 := range m.Combc {
-			num := uint64(num1)
-			for num >= 1<<7 {
-				dAtA5[j4] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j4++
-			}
-			dAtA5[j4] = uint8(num)
-			j4++
-		}
-		i -= j4
-		copy(dAtA[i:], dAtA5[:j4])
-		i = encodeVarintRvt(dAtA, i, uint64(j4))
-		i--
-		dAtA[i] = 0x22
+	if this.Style != that1.Style {
+		return false
 	}
-	if m.Mainc != 0 {
-		i = encodeVarintRvt(dAtA, i, uint64(m.Mainc))
-		i--
-		dAtA[i] = 0x18
+	if this.Text != that1.Text {
+		return false
 	}
-	if m.Y != 0 {
-		i = encodeVarintRvt(dAtA, i, uint64(m.Y))
-		i--
-		dAtA[i] = 0x10
+	if len(this.Combc) != len(that1.Combc) {
+		return false
 	}
-	if m.X != 0 {
-		i = encodeVarintRvt(dAtA, i, uint64(m.X))
-		i--
-		dAtA[i] = 0x8
+	for i := range this.Combc {
+		if this.Combc[i] != that1.Combc[i] {
+			return false
+		}
 	}
-	return len(dAtA) - i, nil
+	return true
 }
-
-func (m *EventMessage) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *AckMessage) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return dAtA[:n], nil
-}
-
-func (m *EventMessage) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
 
-func (m *EventMessage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Event != nil {
-		{
-			size := m.Event.Size()
-			i -= size
-			if _, err := m.Event.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
+	that1, ok := that.(*AckMessage)
+	if !ok {
+		that2, ok := that.(AckMessage)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *EventMessage_Mouse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *EventMessage_Mouse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Mouse != nil {
-		{
-			size, err := m.Mouse.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintRvt(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-func (m *EventMessage_Key) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if this.Frame != that1.Frame {
+		return false
+	}
+	return true
 }
+func (this *PingMessage) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *EventMessage_Key) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Key != nil {
-		{
-			size, err := m.Key.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintRvt(dAtA, i, uint64(size))
+	that1, ok := that.(*PingMessage)
+	if !ok {
+		that2, ok := that.(PingMessage)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i--
-		dAtA[i] = 0x12
 	}
-	return len(dAtA) - i, nil
-}
-func (m *EventMessage_Resize) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *EventMessage_Resize) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Resize != nil {
-		{
-			size, err := m.Resize.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintRvt(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-func (m *EventMessage_Paste) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if this.Seq != that1.Seq {
+		return false
+	}
+	if this.Time != that1.Time {
+		return false
+	}
+	if this.Reply != that1.Reply {
+		return false
+	}
+	return true
 }
+func (this *Glyph) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *EventMessage_Paste) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Paste != nil {
-		{
-			size, err := m.Paste.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintRvt(dAtA, i, uint64(size))
+	that1, ok := that.(*Glyph)
+	if !ok {
+		that2, ok := that.(Glyph)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i--
-		dAtA[i] = 0x22
 	}
-	return len(dAtA) - i, nil
-}
-func (m *EventMouse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *EventMouse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *EventMouse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ModMask != 0 {
-		i = encodeVarintRvt(dAtA, i, uint64(m.ModMask))
-		i--
-		dAtA[i] = 0x20
+	if this.X != that1.X {
+		return false
 	}
-	if m.ButtonMask != 0 {
-		i = encodeVarintRvt(dAtA, i, uint64(m.ButtonMask))
-		i--
-		dAtA[i] = 0x18
+	if this.Y != that1.Y {
+		return false
 	}
-	if m.Y != 0 {
-		i = encodeVarintRvt(dAtA, i, uint64(m.Y))
-		i--
-		dAtA[i] = 0x10
+	if this.Mainc != that1.Mainc {
+		return false
 	}
-	if m.X != 0 {
-		i = encodeVarintRvt(dAtA, i, uint64(m.X))
-		i--
-		dAtA[i] = 0x8
+	if len(this.Combc) != len(that1.Combc) {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *EventKey) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	for i := range this.Combc {
+		if this.Combc[i] != that1.Combc[i] {
+			return false
+		}
 	}
-	return dAtA[:n], nil
-}
-
-func (m *EventKey) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *EventKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ModMask != 0 {
-		i = encodeVarintRvt(dAtA, i, uint64(m.ModMask))
-		i--
-		dAtA[i] = 0x18
+	if this.Fg != that1.Fg {
+		return false
 	}
-	if m.Rune != 0 {
-		i = encodeVarintRvt(dAtA, i, uint64(m.Rune))
-		i--
-		dAtA[i] = 0x10
+	if this.Bg != that1.Bg {
+		return false
 	}
-	if m.Key != 0 {
-		i = encodeVarintRvt(dAtA, i, uint64(m.Key))
-		i--
-		dAtA[i] = 0x8
+	if this.AttrMask != that1.AttrMask {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *EventResize) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if this.Width != that1.Width {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *EventResize) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return true
 }
+func (this *EventMessage) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *EventResize) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Height != 0 {
-		i = encodeVarintRvt(dAtA, i, uint64(m.Height))
-		i--
-		dAtA[i] = 0x10
+	that1, ok := that.(*EventMessage)
+	if !ok {
+		that2, ok := that.(EventMessage)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if m.Width != 0 {
-		i = encodeVarintRvt(dAtA, i, uint64(m.Width))
-		i--
-		dAtA[i] = 0x8
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *EventPaste) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if that1.Event == nil {
+		if this.Event != nil {
+			return false
+		}
+	} else if this.Event == nil {
+		return false
+	} else if !this.Event.Equal(that1.Event) {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *EventPaste) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return true
 }
+func (this *EventMessage_Mouse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *EventPaste) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Start {
-		i--
-		if m.Start {
-			dAtA[i] = 1
+	that1, ok := that.(*EventMessage_Mouse)
+	if !ok {
+		that2, ok := that.(EventMessage_Mouse)
+		if ok {
+			that1 = &that2
 		} else {
-			dAtA[i] = 0
+			return false
 		}
-		i--
-		dAtA[i] = 0x8
 	}
-	return len(dAtA) - i, nil
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Mouse.Equal(that1.Mouse) {
+		return false
+	}
+	return true
 }
-
-func encodeVarintRvt(dAtA []byte, offset int, v uint64) int {
-	offset -= sovRvt(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (this *EventMessage_Key) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *ShareMessage) Size() (n int) {
-	if m == nil {
-		return 0
+
+	that1, ok := that.(*EventMessage_Key)
+	if !ok {
+		that2, ok := that.(EventMessage_Key)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	var l int
-	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovRvt(uint64(l))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if m.Message != nil {
-		n += m.Message.Size()
+	if !this.Key.Equal(that1.Key) {
+		return false
 	}
-	return n
+	return true
 }
+func (this *EventMessage_Resize) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *ShareMessage_Init) Size() (n int) {
-	if m == nil {
-		return 0
+	that1, ok := that.(*EventMessage_Resize)
+	if !ok {
+		that2, ok := that.(EventMessage_Resize)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	var l int
-	_ = l
-	if m.Init != nil {
-		l = m.Init.Size()
-		n += 1 + l + sovRvt(uint64(l))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return n
+	if !this.Resize.Equal(that1.Resize) {
+		return false
+	}
+	return true
 }
-func (m *ShareMessage_Render) Size() (n int) {
-	if m == nil {
-		return 0
+func (this *EventMessage_Paste) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	var l int
-	_ = l
-	if m.Render != nil {
-		l = m.Render.Size()
-		n += 1 + l + sovRvt(uint64(l))
+
+	that1, ok := that.(*EventMessage_Paste)
+	if !ok {
+		that2, ok := that.(EventMessage_Paste)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	return n
-}
-func (m *ShareMessage_Event) Size() (n int) {
-	if m == nil {
-		return 0
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	var l int
-	_ = l
-	if m.Event != nil {
-		l = m.Event.Size()
-		n += 1 + l + sovRvt(uint64(l))
+	if !this.Paste.Equal(that1.Paste) {
+		return false
 	}
-	return n
+	return true
 }
-func (m *InitMessage) Size() (n int) {
-	if m == nil {
-		return 0
+func (this *EventMessage_SelectPane) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	var l int
-	_ = l
-	return n
-}
 
-func (m *RenderMessage) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Cols != 0 {
-		n += 1 + sovRvt(uint64(m.Cols))
+	that1, ok := that.(*EventMessage_SelectPane)
+	if !ok {
+		that2, ok := that.(EventMessage_SelectPane)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if m.Rows != 0 {
-		n += 1 + sovRvt(uint64(m.Rows))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if len(m.Glyphs) > 0 {
-		for _, e := range m.Glyphs {
-			l = e.Size()
-			n += 1 + l + sovRvt(uint64(l))
-		}
+	if !this.SelectPane.Equal(that1.SelectPane) {
+		return false
 	}
-	return n
+	return true
 }
+func (this *EventMouse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *Glyph) Size() (n int) {
-	if m == nil {
-		return 0
+	that1, ok := that.(*EventMouse)
+	if !ok {
+		that2, ok := that.(EventMouse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	var l int
-	_ = l
-	if m.X != 0 {
-		n += 1 + sovRvt(uint64(m.X))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if m.Y != 0 {
-		n += 1 + sovRvt(uint64(m.Y))
+	if this.X != that1.X {
+		return false
 	}
-	if m.Mainc != 0 {
-		n += 1 + sovRvt(uint64(m.Mainc))
+	if this.Y != that1.Y {
+		return false
 	}
-	if len(m.Combc) > 0 {
-		l = 0
-		for _, e := range m.Combc {
-			l += sovRvt(uint64(e))
+	if this.ButtonMask != that1.ButtonMask {
+		return false
+	}
+	if this.ModMask != that1.ModMask {
+		return false
+	}
+	return true
+}
+func (this *EventKey) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*EventKey)
+	if !ok {
+		that2, ok := that.(EventKey)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		n += 1 + sovRvt(uint64(l)) + l
 	}
-	if m.Fg != 0 {
-		n += 1 + sovRvt(uint64(m.Fg))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if m.Bg != 0 {
-		n += 1 + sovRvt(uint64(m.Bg))
+	if this.Key != that1.Key {
+		return false
 	}
-	if m.AttrMask != 0 {
-		n += 1 + sovRvt(uint64(m.AttrMask))
+	if this.Rune != that1.Rune {
+		return false
 	}
-	if m.Width != 0 {
-		n += 1 + sovRvt(uint64(m.Width))
+	if this.ModMask != that1.ModMask {
+		return false
 	}
-	return n
+	return true
 }
+func (this *EventResize) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *EventMessage) Size() (n int) {
-	if m == nil {
-		return 0
+	that1, ok := that.(*EventResize)
+	if !ok {
+		that2, ok := that.(EventResize)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	var l int
-	_ = l
-	if m.Event != nil {
-		n += m.Event.Size()
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return n
+	if this.Width != that1.Width {
+		return false
+	}
+	if this.Height != that1.Height {
+		return false
+	}
+	return true
 }
+func (this *EventPaste) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *EventMessage_Mouse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Mouse != nil {
-		l = m.Mouse.Size()
-		n += 1 + l + sovRvt(uint64(l))
-	}
-	return n
-}
-func (m *EventMessage_Key) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Key != nil {
-		l = m.Key.Size()
-		n += 1 + l + sovRvt(uint64(l))
-	}
-	return n
-}
-func (m *EventMessage_Resize) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Resize != nil {
-		l = m.Resize.Size()
-		n += 1 + l + sovRvt(uint64(l))
-	}
-	return n
-}
-func (m *EventMessage_Paste) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Paste != nil {
-		l = m.Paste.Size()
-		n += 1 + l + sovRvt(uint64(l))
-	}
-	return n
-}
-func (m *EventMouse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.X != 0 {
-		n += 1 + sovRvt(uint64(m.X))
-	}
-	if m.Y != 0 {
-		n += 1 + sovRvt(uint64(m.Y))
+	that1, ok := that.(*EventPaste)
+	if !ok {
+		that2, ok := that.(EventPaste)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if m.ButtonMask != 0 {
-		n += 1 + sovRvt(uint64(m.ButtonMask))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if m.ModMask != 0 {
-		n += 1 + sovRvt(uint64(m.ModMask))
+	if this.Start != that1.Start {
+		return false
 	}
-	return n
+	return true
 }
-
-func (m *EventKey) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Key != 0 {
-		n += 1 + sovRvt(uint64(m.Key))
-	}
-	if m.Rune != 0 {
-		n += 1 + sovRvt(uint64(m.Rune))
-	}
-	if m.ModMask != 0 {
-		n += 1 + sovRvt(uint64(m.ModMask))
+func (this *EventSelectPane) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return n
-}
 
-func (m *EventResize) Size() (n int) {
-	if m == nil {
-		return 0
+	that1, ok := that.(*EventSelectPane)
+	if !ok {
+		that2, ok := that.(EventSelectPane)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	var l int
-	_ = l
-	if m.Width != 0 {
-		n += 1 + sovRvt(uint64(m.Width))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if m.Height != 0 {
-		n += 1 + sovRvt(uint64(m.Height))
+	if this.Pane != that1.Pane {
+		return false
 	}
-	return n
+	return true
 }
-
-func (m *EventPaste) Size() (n int) {
-	if m == nil {
-		return 0
+func (this *ShareMessage) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	var l int
-	_ = l
-	if m.Start {
-		n += 2
+	s := make([]string, 0, 14)
+	s = append(s, "&rvt.ShareMessage{")
+	s = append(s, "Id: "+fmt.Sprintf("%#v", this.Id)+",\n")
+	if this.Message != nil {
+		s = append(s, "Message: "+fmt.Sprintf("%#v", this.Message)+",\n")
 	}
-	return n
-}
-
-func sovRvt(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozRvt(x uint64) (n int) {
-	return sovRvt(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-func (this *ShareMessage) String() string {
+func (this *ShareMessage_Init) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := strings.Join([]string{`&ShareMessage{`,
-		`Id:` + fmt.Sprintf("%v", this.Id) + `,`,
-		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
-		`}`,
-	}, "")
+	s := strings.Join([]string{`&rvt.ShareMessage_Init{` +
+		`Init:` + fmt.Sprintf("%#v", this.Init) + `}`}, ", ")
 	return s
 }
-func (this *ShareMessage_Init) String() string {
+func (this *ShareMessage_Render) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := strings.Join([]string{`&ShareMessage_Init{`,
-		`Init:` + strings.Replace(fmt.Sprintf("%v", this.Init), "InitMessage", "InitMessage", 1) + `,`,
-		`}`,
-	}, "")
+	s := strings.Join([]string{`&rvt.ShareMessage_Render{` +
+		`Render:` + fmt.Sprintf("%#v", this.Render) + `}`}, ", ")
 	return s
 }
-func (this *ShareMessage_Render) String() string {
+func (this *ShareMessage_Event) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := strings.Join([]string{`&ShareMessage_Render{`,
-		`Render:` + strings.Replace(fmt.Sprintf("%v", this.Render), "RenderMessage", "RenderMessage", 1) + `,`,
-		`}`,
-	}, "")
+	s := strings.Join([]string{`&rvt.ShareMessage_Event{` +
+		`Event:` + fmt.Sprintf("%#v", this.Event) + `}`}, ", ")
 	return s
 }
-func (this *ShareMessage_Event) String() string {
+func (this *ShareMessage_Exit) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := strings.Join([]string{`&ShareMessage_Event{`,
-		`Event:` + strings.Replace(fmt.Sprintf("%v", this.Event), "EventMessage", "EventMessage", 1) + `,`,
-		`}`,
-	}, "")
+	s := strings.Join([]string{`&rvt.ShareMessage_Exit{` +
+		`Exit:` + fmt.Sprintf("%#v", this.Exit) + `}`}, ", ")
 	return s
 }
-func (this *InitMessage) String() string {
+func (this *ShareMessage_Bell) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := strings.Join([]string{`&InitMessage{`,
-		`}`,
-	}, "")
+	s := strings.Join([]string{`&rvt.ShareMessage_Bell{` +
+		`Bell:` + fmt.Sprintf("%#v", this.Bell) + `}`}, ", ")
 	return s
 }
-func (this *RenderMessage) String() string {
+func (this *ShareMessage_Leave) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	repeatedStringForGlyphs := "[]*Glyph{"
-	for _, f := range this.Glyphs {
-		repeatedStringForGlyphs += strings.Replace(f.String(), "Glyph", "Glyph", 1) + ","
-	}
-	repeatedStringForGlyphs += "}"
-	s := strings.Join([]string{`&RenderMessage{`,
-		`Cols:` + fmt.Sprintf("%v", this.Cols) + `,`,
-		`Rows:` + fmt.Sprintf("%v", this.Rows) + `,`,
-		`Glyphs:` + repeatedStringForGlyphs + `,`,
-		`}`,
-	}, "")
+	s := strings.Join([]string{`&rvt.ShareMessage_Leave{` +
+		`Leave:` + fmt.Sprintf("%#v", this.Leave) + `}`}, ", ")
 	return s
 }
-func (this *Glyph) String() string {
+func (this *ShareMessage_Ack) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := strings.Join([]string{`&Glyph{`,
-		`X:` + fmt.Sprintf("%v", this.X) + `,`,
-		`Y:` + fmt.Sprintf("%v", this.Y) + `,`,
-		`Mainc:` + fmt.Sprintf("%v", this.Mainc) + `,`,
-		`Combc:` + fmt.Sprintf("%v", this.Combc) + `,`,
-		`Fg:` + fmt.Sprintf("%v", this.Fg) + `,`,
-		`Bg:` + fmt.Sprintf("%v", this.Bg) + `,`,
-		`AttrMask:` + fmt.Sprintf("%v", this.AttrMask) + `,`,
-		`Width:` + fmt.Sprintf("%v", this.Width) + `,`,
-		`}`,
-	}, "")
+	s := strings.Join([]string{`&rvt.ShareMessage_Ack{` +
+		`Ack:` + fmt.Sprintf("%#v", this.Ack) + `}`}, ", ")
 	return s
 }
-func (this *EventMessage) String() string {
+func (this *ShareMessage_Ping) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := strings.Join([]string{`&EventMessage{`,
-		`Event:` + fmt.Sprintf("%v", this.Event) + `,`,
-		`}`,
-	}, "")
+	s := strings.Join([]string{`&rvt.ShareMessage_Ping{` +
+		`Ping:` + fmt.Sprintf("%#v", this.Ping) + `}`}, ", ")
 	return s
 }
-func (this *EventMessage_Mouse) String() string {
+func (this *ShareMessage_Clipboard) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := strings.Join([]string{`&EventMessage_Mouse{`,
-		`Mouse:` + strings.Replace(fmt.Sprintf("%v", this.Mouse), "EventMouse", "EventMouse", 1) + `,`,
-		`}`,
-	}, "")
+	s := strings.Join([]string{`&rvt.ShareMessage_Clipboard{` +
+		`Clipboard:` + fmt.Sprintf("%#v", this.Clipboard) + `}`}, ", ")
 	return s
 }
-func (this *EventMessage_Key) String() string {
+func (this *InitMessage) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := strings.Join([]string{`&EventMessage_Key{`,
-		`Key:` + strings.Replace(fmt.Sprintf("%v", this.Key), "EventKey", "EventKey", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	s := make([]string, 0, 11)
+	s = append(s, "&rvt.InitMessage{")
+	s = append(s, "Token: "+fmt.Sprintf("%#v", this.Token)+",\n")
+	s = append(s, "Version: "+fmt.Sprintf("%#v", this.Version)+",\n")
+	s = append(s, "Features: "+fmt.Sprintf("%#v", this.Features)+",\n")
+	s = append(s, "Cols: "+fmt.Sprintf("%#v", this.Cols)+",\n")
+	s = append(s, "Rows: "+fmt.Sprintf("%#v", this.Rows)+",\n")
+	s = append(s, "Colors: "+fmt.Sprintf("%#v", this.Colors)+",\n")
+	s = append(s, "MinVersion: "+fmt.Sprintf("%#v", this.MinVersion)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-func (this *EventMessage_Resize) String() string {
+func (this *ExitMessage) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := strings.Join([]string{`&EventMessage_Resize{`,
-		`Resize:` + strings.Replace(fmt.Sprintf("%v", this.Resize), "EventResize", "EventResize", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	s := make([]string, 0, 8)
+	s = append(s, "&rvt.ExitMessage{")
+	s = append(s, "Title: "+fmt.Sprintf("%#v", this.Title)+",\n")
+	s = append(s, "Code: "+fmt.Sprintf("%#v", this.Code)+",\n")
+	s = append(s, "Signal: "+fmt.Sprintf("%#v", this.Signal)+",\n")
+	s = append(s, "Pane: "+fmt.Sprintf("%#v", this.Pane)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-func (this *EventMessage_Paste) String() string {
+func (this *BellMessage) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := strings.Join([]string{`&EventMessage_Paste{`,
-		`Paste:` + strings.Replace(fmt.Sprintf("%v", this.Paste), "EventPaste", "EventPaste", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	s := make([]string, 0, 5)
+	s = append(s, "&rvt.BellMessage{")
+	s = append(s, "Pane: "+fmt.Sprintf("%#v", this.Pane)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-func (this *EventMouse) String() string {
+func (this *ClipboardMessage) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := strings.Join([]string{`&EventMouse{`,
-		`X:` + fmt.Sprintf("%v", this.X) + `,`,
-		`Y:` + fmt.Sprintf("%v", this.Y) + `,`,
-		`ButtonMask:` + fmt.Sprintf("%v", this.ButtonMask) + `,`,
-		`ModMask:` + fmt.Sprintf("%v", this.ModMask) + `,`,
-		`}`,
-	}, "")
-	return s
+	s := make([]string, 0, 6)
+	s = append(s, "&rvt.ClipboardMessage{")
+	s = append(s, "Pane: "+fmt.Sprintf("%#v", this.Pane)+",\n")
+	s = append(s, "Data: "+fmt.Sprintf("%#v", this.Data)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-func (this *EventKey) String() string {
+func (this *LeaveMessage) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := strings.Join([]string{`&EventKey{`,
-		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
-		`Rune:` + fmt.Sprintf("%v", this.Rune) + `,`,
-		`ModMask:` + fmt.Sprintf("%v", this.ModMask) + `,`,
-		`}`,
-	}, "")
+	s := make([]string, 0, 5)
+	s = append(s, "&rvt.LeaveMessage{")
+	s = append(s, "Peer: "+fmt.Sprintf("%#v", this.Peer)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *RenderMessage) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 11)
+	s = append(s, "&rvt.RenderMessage{")
+	s = append(s, "Cols: "+fmt.Sprintf("%#v", this.Cols)+",\n")
+	s = append(s, "Rows: "+fmt.Sprintf("%#v", this.Rows)+",\n")
+	if this.Glyphs != nil {
+		s = append(s, "Glyphs: "+fmt.Sprintf("%#v", this.Glyphs)+",\n")
+	}
+	s = append(s, "Frame: "+fmt.Sprintf("%#v", this.Frame)+",\n")
+	if this.Styles != nil {
+		s = append(s, "Styles: "+fmt.Sprintf("%#v", this.Styles)+",\n")
+	}
+	if this.Runs != nil {
+		s = append(s, "Runs: "+fmt.Sprintf("%#v", this.Runs)+",\n")
+	}
+	if this.Cursor != nil {
+		s = append(s, "Cursor: "+fmt.Sprintf("%#v", this.Cursor)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *Cursor) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&rvt.Cursor{")
+	s = append(s, "X: "+fmt.Sprintf("%#v", this.X)+",\n")
+	s = append(s, "Y: "+fmt.Sprintf("%#v", this.Y)+",\n")
+	s = append(s, "Predict: "+fmt.Sprintf("%#v", this.Predict)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *Style) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&rvt.Style{")
+	s = append(s, "Fg: "+fmt.Sprintf("%#v", this.Fg)+",\n")
+	s = append(s, "Bg: "+fmt.Sprintf("%#v", this.Bg)+",\n")
+	s = append(s, "AttrMask: "+fmt.Sprintf("%#v", this.AttrMask)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *Run) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 9)
+	s = append(s, "&rvt.Run{")
+	s = append(s, "X: "+fmt.Sprintf("%#v", this.X)+",\n")
+	s = append(s, "Y: "+fmt.Sprintf("%#v", this.Y)+",\n")
+	s = append(s, "Style: "+fmt.Sprintf("%#v", this.Style)+",\n")
+	s = append(s, "Text: "+fmt.Sprintf("%#v", this.Text)+",\n")
+	s = append(s, "Combc: "+fmt.Sprintf("%#v", this.Combc)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *AckMessage) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&rvt.AckMessage{")
+	s = append(s, "Frame: "+fmt.Sprintf("%#v", this.Frame)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *PingMessage) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&rvt.PingMessage{")
+	s = append(s, "Seq: "+fmt.Sprintf("%#v", this.Seq)+",\n")
+	s = append(s, "Time: "+fmt.Sprintf("%#v", this.Time)+",\n")
+	s = append(s, "Reply: "+fmt.Sprintf("%#v", this.Reply)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *Glyph) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 12)
+	s = append(s, "&rvt.Glyph{")
+	s = append(s, "X: "+fmt.Sprintf("%#v", this.X)+",\n")
+	s = append(s, "Y: "+fmt.Sprintf("%#v", this.Y)+",\n")
+	s = append(s, "Mainc: "+fmt.Sprintf("%#v", this.Mainc)+",\n")
+	s = append(s, "Combc: "+fmt.Sprintf("%#v", this.Combc)+",\n")
+	s = append(s, "Fg: "+fmt.Sprintf("%#v", this.Fg)+",\n")
+	s = append(s, "Bg: "+fmt.Sprintf("%#v", this.Bg)+",\n")
+	s = append(s, "AttrMask: "+fmt.Sprintf("%#v", this.AttrMask)+",\n")
+	s = append(s, "Width: "+fmt.Sprintf("%#v", this.Width)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *EventMessage) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 9)
+	s = append(s, "&rvt.EventMessage{")
+	if this.Event != nil {
+		s = append(s, "Event: "+fmt.Sprintf("%#v", this.Event)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *EventMessage_Mouse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&rvt.EventMessage_Mouse{` +
+		`Mouse:` + fmt.Sprintf("%#v", this.Mouse) + `}`}, ", ")
 	return s
 }
-func (this *EventResize) String() string {
+func (this *EventMessage_Key) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := strings.Join([]string{`&EventResize{`,
-		`Width:` + fmt.Sprintf("%v", this.Width) + `,`,
-		`Height:` + fmt.Sprintf("%v", this.Height) + `,`,
-		`}`,
-	}, "")
+	s := strings.Join([]string{`&rvt.EventMessage_Key{` +
+		`Key:` + fmt.Sprintf("%#v", this.Key) + `}`}, ", ")
 	return s
 }
-func (this *EventPaste) String() string {
+func (this *EventMessage_Resize) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&rvt.EventMessage_Resize{` +
+		`Resize:` + fmt.Sprintf("%#v", this.Resize) + `}`}, ", ")
+	return s
+}
+func (this *EventMessage_Paste) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&rvt.EventMessage_Paste{` +
+		`Paste:` + fmt.Sprintf("%#v", this.Paste) + `}`}, ", ")
+	return s
+}
+func (this *EventMessage_SelectPane) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&rvt.EventMessage_SelectPane{` +
+		`SelectPane:` + fmt.Sprintf("%#v", this.SelectPane) + `}`}, ", ")
+	return s
+}
+func (this *EventMouse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 8)
+	s = append(s, "&rvt.EventMouse{")
+	s = append(s, "X: "+fmt.Sprintf("%#v", this.X)+",\n")
+	s = append(s, "Y: "+fmt.Sprintf("%#v", this.Y)+",\n")
+	s = append(s, "ButtonMask: "+fmt.Sprintf("%#v", this.ButtonMask)+",\n")
+	s = append(s, "ModMask: "+fmt.Sprintf("%#v", this.ModMask)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *EventKey) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&rvt.EventKey{")
+	s = append(s, "Key: "+fmt.Sprintf("%#v", this.Key)+",\n")
+	s = append(s, "Rune: "+fmt.Sprintf("%#v", this.Rune)+",\n")
+	s = append(s, "ModMask: "+fmt.Sprintf("%#v", this.ModMask)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *EventResize) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&rvt.EventResize{")
+	s = append(s, "Width: "+fmt.Sprintf("%#v", this.Width)+",\n")
+	s = append(s, "Height: "+fmt.Sprintf("%#v", this.Height)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *EventPaste) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&rvt.EventPaste{")
+	s = append(s, "Start: "+fmt.Sprintf("%#v", this.Start)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *EventSelectPane) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := strings.Join([]string{`&EventPaste{`,
-		`Start:` + fmt.Sprintf("%v", this.Start) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func valueToStringRvt(v interface{}) string {
-	rv := reflect.ValueOf(v)
-	if rv.IsNil() {
-		return "nil"
+	s := make([]string, 0, 5)
+	s = append(s, "&rvt.EventSelectPane{")
+	s = append(s, "Pane: "+fmt.Sprintf("%#v", this.Pane)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func valueToGoStringRvt(v interface{}, typ string) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// ScreenClient is the client API for Screen service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type ScreenClient interface {
+	Share(ctx context.Context, opts ...grpc.CallOption) (Screen_ShareClient, error)
+}
+
+type screenClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewScreenClient(cc *grpc.ClientConn) ScreenClient {
+	return &screenClient{cc}
+}
+
+func (c *screenClient) Share(ctx context.Context, opts ...grpc.CallOption) (Screen_ShareClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Screen_serviceDesc.Streams[0], "/ptmux.rvt.v1.Screen/Share", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &screenShareClient{stream}
+	return x, nil
+}
+
+type Screen_ShareClient interface {
+	Send(*ShareMessage) error
+	Recv() (*ShareMessage, error)
+	grpc.ClientStream
+}
+
+type screenShareClient struct {
+	grpc.ClientStream
+}
+
+func (x *screenShareClient) Send(m *ShareMessage) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *screenShareClient) Recv() (*ShareMessage, error) {
+	m := new(ShareMessage)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// ScreenServer is the server API for Screen service.
+type ScreenServer interface {
+	Share(Screen_ShareServer) error
+}
+
+// UnimplementedScreenServer can be embedded to have forward compatible implementations.
+type UnimplementedScreenServer struct {
+}
+
+func (*UnimplementedScreenServer) Share(srv Screen_ShareServer) error {
+	return status.Errorf(codes.Unimplemented, "method Share not implemented")
+}
+
+func RegisterScreenServer(s *grpc.Server, srv ScreenServer) {
+	s.RegisterService(&_Screen_serviceDesc, srv)
+}
+
+func _Screen_Share_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(ScreenServer).Share(&screenShareServer{stream})
+}
+
+type Screen_ShareServer interface {
+	Send(*ShareMessage) error
+	Recv() (*ShareMessage, error)
+	grpc.ServerStream
+}
+
+type screenShareServer struct {
+	grpc.ServerStream
+}
+
+func (x *screenShareServer) Send(m *ShareMessage) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *screenShareServer) Recv() (*ShareMessage, error) {
+	m := new(ShareMessage)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+var _Screen_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ptmux.rvt.v1.Screen",
+	HandlerType: (*ScreenServer)(nil),
+	Methods:     []grpc.MethodDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Share",
+			Handler:       _Screen_Share_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "rvt.proto",
+}
+
+func (m *ShareMessage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ShareMessage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ShareMessage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Message != nil {
+		{
+			size := m.Message.Size()
+			i -= size
+			if _, err := m.Message.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintRvt(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ShareMessage_Init) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ShareMessage_Init) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Init != nil {
+		{
+			size, err := m.Init.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRvt(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	return len(dAtA) - i, nil
+}
+func (m *ShareMessage_Render) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ShareMessage_Render) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Render != nil {
+		{
+			size, err := m.Render.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRvt(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *ShareMessage_Event) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ShareMessage_Event) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Event != nil {
+		{
+			size, err := m.Event.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRvt(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	return len(dAtA) - i, nil
+}
+func (m *ShareMessage_Exit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ShareMessage_Exit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Exit != nil {
+		{
+			size, err := m.Exit.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRvt(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *ShareMessage_Bell) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ShareMessage_Bell) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Bell != nil {
+		{
+			size, err := m.Bell.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRvt(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	return len(dAtA) - i, nil
+}
+func (m *ShareMessage_Leave) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ShareMessage_Leave) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Leave != nil {
+		{
+			size, err := m.Leave.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRvt(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *ShareMessage_Ack) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ShareMessage_Ack) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Ack != nil {
+		{
+			size, err := m.Ack.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRvt(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	return len(dAtA) - i, nil
+}
+func (m *ShareMessage_Ping) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ShareMessage_Ping) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Ping != nil {
+		{
+			size, err := m.Ping.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRvt(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *ShareMessage_Clipboard) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ShareMessage_Clipboard) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Clipboard != nil {
+		{
+			size, err := m.Clipboard.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRvt(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
+	return len(dAtA) - i, nil
+}
+func (m *InitMessage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InitMessage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InitMessage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MinVersion != 0 {
+		i = encodeVarintRvt(dAtA, i, uint64(m.MinVersion))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Colors != 0 {
+		i = encodeVarintRvt(dAtA, i, uint64(m.Colors))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Rows != 0 {
+		i = encodeVarintRvt(dAtA, i, uint64(m.Rows))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Cols != 0 {
+		i = encodeVarintRvt(dAtA, i, uint64(m.Cols))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Features) > 0 {
+		for iNdEx := len(m.Features) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Features[iNdEx])
+			copy(dAtA[i:], m.Features[iNdEx])
+			i = encodeVarintRvt(dAtA, i, uint64(len(m.Features[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Version != 0 {
+		i = encodeVarintRvt(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Token) > 0 {
+		i -= len(m.Token)
+		copy(dAtA[i:], m.Token)
+		i = encodeVarintRvt(dAtA, i, uint64(len(m.Token)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ExitMessage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExitMessage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExitMessage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pane != 0 {
+		i = encodeVarintRvt(dAtA, i, uint64(m.Pane))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Signal) > 0 {
+		i -= len(m.Signal)
+		copy(dAtA[i:], m.Signal)
+		i = encodeVarintRvt(dAtA, i, uint64(len(m.Signal)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Code != 0 {
+		i = encodeVarintRvt(dAtA, i, uint64(m.Code))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintRvt(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BellMessage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BellMessage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BellMessage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pane != 0 {
+		i = encodeVarintRvt(dAtA, i, uint64(m.Pane))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ClipboardMessage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClipboardMessage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClipboardMessage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintRvt(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Pane != 0 {
+		i = encodeVarintRvt(dAtA, i, uint64(m.Pane))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LeaveMessage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LeaveMessage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LeaveMessage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Peer) > 0 {
+		i -= len(m.Peer)
+		copy(dAtA[i:], m.Peer)
+		i = encodeVarintRvt(dAtA, i, uint64(len(m.Peer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RenderMessage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RenderMessage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RenderMessage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Cursor != nil {
+		{
+			size, err := m.Cursor.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRvt(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Runs) > 0 {
+		for iNdEx := len(m.Runs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Runs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRvt(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Styles) > 0 {
+		for iNdEx := len(m.Styles) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Styles[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRvt(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.Frame != 0 {
+		i = encodeVarintRvt(dAtA, i, uint64(m.Frame))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Glyphs) > 0 {
+		for iNdEx := len(m.Glyphs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Glyphs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRvt(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Rows != 0 {
+		i = encodeVarintRvt(dAtA, i, uint64(m.Rows))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Cols != 0 {
+		i = encodeVarintRvt(dAtA, i, uint64(m.Cols))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Cursor) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Cursor) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Cursor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Predict {
+		i--
+		if m.Predict {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Y != 0 {
+		i = encodeVarintRvt(dAtA, i, uint64(m.Y))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.X != 0 {
+		i = encodeVarintRvt(dAtA, i, uint64(m.X))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Style) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Style) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Style) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AttrMask != 0 {
+		i = encodeVarintRvt(dAtA, i, uint64(m.AttrMask))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Bg != 0 {
+		i = encodeVarintRvt(dAtA, i, uint64(m.Bg))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Fg != 0 {
+		i = encodeVarintRvt(dAtA, i, uint64(m.Fg))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Run) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Run) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Run) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Combc) > 0 {
+		dAtA12 := make([]byte, len(m.Combc)*10)
+		var j11 int
+		for _, num1 := range m.Combc {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA12[j11] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j11++
+			}
+			dAtA12[j11] = uint8(num)
+			j11++
+		}
+		i -= j11
+		copy(dAtA[i:], dAtA12[:j11])
+		i = encodeVarintRvt(dAtA, i, uint64(j11))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Text) > 0 {
+		i -= len(m.Text)
+		copy(dAtA[i:], m.Text)
+		i = encodeVarintRvt(dAtA, i, uint64(len(m.Text)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Style != 0 {
+		i = encodeVarintRvt(dAtA, i, uint64(m.Style))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Y != 0 {
+		i = encodeVarintRvt(dAtA, i, uint64(m.Y))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.X != 0 {
+		i = encodeVarintRvt(dAtA, i, uint64(m.X))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AckMessage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AckMessage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AckMessage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Frame != 0 {
+		i = encodeVarintRvt(dAtA, i, uint64(m.Frame))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PingMessage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PingMessage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PingMessage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Reply {
+		i--
+		if m.Reply {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Time != 0 {
+		i = encodeVarintRvt(dAtA, i, uint64(m.Time))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Seq != 0 {
+		i = encodeVarintRvt(dAtA, i, uint64(m.Seq))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Glyph) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Glyph) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Glyph) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Width != 0 {
+		i = encodeVarintRvt(dAtA, i, uint64(m.Width))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.AttrMask != 0 {
+		i = encodeVarintRvt(dAtA, i, uint64(m.AttrMask))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Bg != 0 {
+		i = encodeVarintRvt(dAtA, i, uint64(m.Bg))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Fg != 0 {
+		i = encodeVarintRvt(dAtA, i, uint64(m.Fg))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Combc) > 0 {
+		dAtA14 := make([]byte, len(m.Combc)*10)
+		var j13 int
+		for _, num1 := range m.Combc {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA14[j13] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j13++
+			}
+			dAtA14[j13] = uint8(num)
+			j13++
+		}
+		i -= j13
+		copy(dAtA[i:], dAtA14[:j13])
+		i = encodeVarintRvt(dAtA, i, uint64(j13))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Mainc != 0 {
+		i = encodeVarintRvt(dAtA, i, uint64(m.Mainc))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Y != 0 {
+		i = encodeVarintRvt(dAtA, i, uint64(m.Y))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.X != 0 {
+		i = encodeVarintRvt(dAtA, i, uint64(m.X))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMessage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMessage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMessage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Event != nil {
+		{
+			size := m.Event.Size()
+			i -= size
+			if _, err := m.Event.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMessage_Mouse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMessage_Mouse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Mouse != nil {
+		{
+			size, err := m.Mouse.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRvt(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+func (m *EventMessage_Key) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMessage_Key) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Key != nil {
+		{
+			size, err := m.Key.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRvt(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	return len(dAtA) - i, nil
+}
+func (m *EventMessage_Resize) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMessage_Resize) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Resize != nil {
+		{
+			size, err := m.Resize.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRvt(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *EventMessage_Paste) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMessage_Paste) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Paste != nil {
+		{
+			size, err := m.Paste.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRvt(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	return len(dAtA) - i, nil
+}
+func (m *EventMessage_SelectPane) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMessage_SelectPane) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.SelectPane != nil {
+		{
+			size, err := m.SelectPane.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRvt(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *EventMouse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMouse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMouse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ModMask != 0 {
+		i = encodeVarintRvt(dAtA, i, uint64(m.ModMask))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ButtonMask != 0 {
+		i = encodeVarintRvt(dAtA, i, uint64(m.ButtonMask))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Y != 0 {
+		i = encodeVarintRvt(dAtA, i, uint64(m.Y))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.X != 0 {
+		i = encodeVarintRvt(dAtA, i, uint64(m.X))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventKey) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventKey) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ModMask != 0 {
+		i = encodeVarintRvt(dAtA, i, uint64(m.ModMask))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Rune != 0 {
+		i = encodeVarintRvt(dAtA, i, uint64(m.Rune))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Key != 0 {
+		i = encodeVarintRvt(dAtA, i, uint64(m.Key))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventResize) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventResize) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventResize) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintRvt(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Width != 0 {
+		i = encodeVarintRvt(dAtA, i, uint64(m.Width))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventPaste) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventPaste) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventPaste) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Start {
+		i--
+		if m.Start {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventSelectPane) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventSelectPane) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventSelectPane) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pane != 0 {
+		i = encodeVarintRvt(dAtA, i, uint64(m.Pane))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintRvt(dAtA []byte, offset int, v uint64) int {
+	offset -= sovRvt(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ShareMessage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovRvt(uint64(l))
+	}
+	if m.Message != nil {
+		n += m.Message.Size()
+	}
+	return n
+}
+
+func (m *ShareMessage_Init) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Init != nil {
+		l = m.Init.Size()
+		n += 1 + l + sovRvt(uint64(l))
+	}
+	return n
+}
+func (m *ShareMessage_Render) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Render != nil {
+		l = m.Render.Size()
+		n += 1 + l + sovRvt(uint64(l))
+	}
+	return n
+}
+func (m *ShareMessage_Event) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Event != nil {
+		l = m.Event.Size()
+		n += 1 + l + sovRvt(uint64(l))
+	}
+	return n
+}
+func (m *ShareMessage_Exit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Exit != nil {
+		l = m.Exit.Size()
+		n += 1 + l + sovRvt(uint64(l))
+	}
+	return n
+}
+func (m *ShareMessage_Bell) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Bell != nil {
+		l = m.Bell.Size()
+		n += 1 + l + sovRvt(uint64(l))
+	}
+	return n
+}
+func (m *ShareMessage_Leave) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Leave != nil {
+		l = m.Leave.Size()
+		n += 1 + l + sovRvt(uint64(l))
+	}
+	return n
+}
+func (m *ShareMessage_Ack) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Ack != nil {
+		l = m.Ack.Size()
+		n += 1 + l + sovRvt(uint64(l))
+	}
+	return n
+}
+func (m *ShareMessage_Ping) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Ping != nil {
+		l = m.Ping.Size()
+		n += 1 + l + sovRvt(uint64(l))
+	}
+	return n
+}
+func (m *ShareMessage_Clipboard) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Clipboard != nil {
+		l = m.Clipboard.Size()
+		n += 1 + l + sovRvt(uint64(l))
+	}
+	return n
+}
+func (m *InitMessage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Token)
+	if l > 0 {
+		n += 1 + l + sovRvt(uint64(l))
+	}
+	if m.Version != 0 {
+		n += 1 + sovRvt(uint64(m.Version))
+	}
+	if len(m.Features) > 0 {
+		for _, s := range m.Features {
+			l = len(s)
+			n += 1 + l + sovRvt(uint64(l))
+		}
+	}
+	if m.Cols != 0 {
+		n += 1 + sovRvt(uint64(m.Cols))
+	}
+	if m.Rows != 0 {
+		n += 1 + sovRvt(uint64(m.Rows))
+	}
+	if m.Colors != 0 {
+		n += 1 + sovRvt(uint64(m.Colors))
+	}
+	if m.MinVersion != 0 {
+		n += 1 + sovRvt(uint64(m.MinVersion))
+	}
+	return n
+}
+
+func (m *ExitMessage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovRvt(uint64(l))
+	}
+	if m.Code != 0 {
+		n += 1 + sovRvt(uint64(m.Code))
+	}
+	l = len(m.Signal)
+	if l > 0 {
+		n += 1 + l + sovRvt(uint64(l))
+	}
+	if m.Pane != 0 {
+		n += 1 + sovRvt(uint64(m.Pane))
+	}
+	return n
+}
+
+func (m *BellMessage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pane != 0 {
+		n += 1 + sovRvt(uint64(m.Pane))
+	}
+	return n
+}
+
+func (m *ClipboardMessage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pane != 0 {
+		n += 1 + sovRvt(uint64(m.Pane))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovRvt(uint64(l))
+	}
+	return n
+}
+
+func (m *LeaveMessage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Peer)
+	if l > 0 {
+		n += 1 + l + sovRvt(uint64(l))
+	}
+	return n
+}
+
+func (m *RenderMessage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Cols != 0 {
+		n += 1 + sovRvt(uint64(m.Cols))
+	}
+	if m.Rows != 0 {
+		n += 1 + sovRvt(uint64(m.Rows))
+	}
+	if len(m.Glyphs) > 0 {
+		for _, e := range m.Glyphs {
+			l = e.Size()
+			n += 1 + l + sovRvt(uint64(l))
+		}
+	}
+	if m.Frame != 0 {
+		n += 1 + sovRvt(uint64(m.Frame))
+	}
+	if len(m.Styles) > 0 {
+		for _, e := range m.Styles {
+			l = e.Size()
+			n += 1 + l + sovRvt(uint64(l))
+		}
+	}
+	if len(m.Runs) > 0 {
+		for _, e := range m.Runs {
+			l = e.Size()
+			n += 1 + l + sovRvt(uint64(l))
+		}
+	}
+	if m.Cursor != nil {
+		l = m.Cursor.Size()
+		n += 1 + l + sovRvt(uint64(l))
+	}
+	return n
+}
+
+func (m *Cursor) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.X != 0 {
+		n += 1 + sovRvt(uint64(m.X))
+	}
+	if m.Y != 0 {
+		n += 1 + sovRvt(uint64(m.Y))
+	}
+	if m.Predict {
+		n += 2
+	}
+	return n
+}
+
+func (m *Style) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Fg != 0 {
+		n += 1 + sovRvt(uint64(m.Fg))
+	}
+	if m.Bg != 0 {
+		n += 1 + sovRvt(uint64(m.Bg))
+	}
+	if m.AttrMask != 0 {
+		n += 1 + sovRvt(uint64(m.AttrMask))
+	}
+	return n
+}
+
+func (m *Run) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.X != 0 {
+		n += 1 + sovRvt(uint64(m.X))
+	}
+	if m.Y != 0 {
+		n += 1 + sovRvt(uint64(m.Y))
+	}
+	if m.Style != 0 {
+		n += 1 + sovRvt(uint64(m.Style))
+	}
+	l = len(m.Text)
+	if l > 0 {
+		n += 1 + l + sovRvt(uint64(l))
+	}
+	if len(m.Combc) > 0 {
+		l = 0
+		for _, e := range m.Combc {
+			l += sovRvt(uint64(e))
+		}
+		n += 1 + sovRvt(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *AckMessage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Frame != 0 {
+		n += 1 + sovRvt(uint64(m.Frame))
+	}
+	return n
+}
+
+func (m *PingMessage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Seq != 0 {
+		n += 1 + sovRvt(uint64(m.Seq))
+	}
+	if m.Time != 0 {
+		n += 1 + sovRvt(uint64(m.Time))
+	}
+	if m.Reply {
+		n += 2
+	}
+	return n
+}
+
+func (m *Glyph) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.X != 0 {
+		n += 1 + sovRvt(uint64(m.X))
+	}
+	if m.Y != 0 {
+		n += 1 + sovRvt(uint64(m.Y))
+	}
+	if m.Mainc != 0 {
+		n += 1 + sovRvt(uint64(m.Mainc))
+	}
+	if len(m.Combc) > 0 {
+		l = 0
+		for _, e := range m.Combc {
+			l += sovRvt(uint64(e))
+		}
+		n += 1 + sovRvt(uint64(l)) + l
+	}
+	if m.Fg != 0 {
+		n += 1 + sovRvt(uint64(m.Fg))
+	}
+	if m.Bg != 0 {
+		n += 1 + sovRvt(uint64(m.Bg))
+	}
+	if m.AttrMask != 0 {
+		n += 1 + sovRvt(uint64(m.AttrMask))
+	}
+	if m.Width != 0 {
+		n += 1 + sovRvt(uint64(m.Width))
+	}
+	return n
+}
+
+func (m *EventMessage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Event != nil {
+		n += m.Event.Size()
+	}
+	return n
+}
+
+func (m *EventMessage_Mouse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Mouse != nil {
+		l = m.Mouse.Size()
+		n += 1 + l + sovRvt(uint64(l))
+	}
+	return n
+}
+func (m *EventMessage_Key) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Key != nil {
+		l = m.Key.Size()
+		n += 1 + l + sovRvt(uint64(l))
+	}
+	return n
+}
+func (m *EventMessage_Resize) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Resize != nil {
+		l = m.Resize.Size()
+		n += 1 + l + sovRvt(uint64(l))
+	}
+	return n
+}
+func (m *EventMessage_Paste) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Paste != nil {
+		l = m.Paste.Size()
+		n += 1 + l + sovRvt(uint64(l))
+	}
+	return n
+}
+func (m *EventMessage_SelectPane) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SelectPane != nil {
+		l = m.SelectPane.Size()
+		n += 1 + l + sovRvt(uint64(l))
+	}
+	return n
+}
+func (m *EventMouse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.X != 0 {
+		n += 1 + sovRvt(uint64(m.X))
+	}
+	if m.Y != 0 {
+		n += 1 + sovRvt(uint64(m.Y))
+	}
+	if m.ButtonMask != 0 {
+		n += 1 + sovRvt(uint64(m.ButtonMask))
+	}
+	if m.ModMask != 0 {
+		n += 1 + sovRvt(uint64(m.ModMask))
+	}
+	return n
+}
+
+func (m *EventKey) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Key != 0 {
+		n += 1 + sovRvt(uint64(m.Key))
+	}
+	if m.Rune != 0 {
+		n += 1 + sovRvt(uint64(m.Rune))
+	}
+	if m.ModMask != 0 {
+		n += 1 + sovRvt(uint64(m.ModMask))
+	}
+	return n
+}
+
+func (m *EventResize) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Width != 0 {
+		n += 1 + sovRvt(uint64(m.Width))
+	}
+	if m.Height != 0 {
+		n += 1 + sovRvt(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *EventPaste) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Start {
+		n += 2
+	}
+	return n
+}
+
+func (m *EventSelectPane) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pane != 0 {
+		n += 1 + sovRvt(uint64(m.Pane))
+	}
+	return n
+}
+
+func sovRvt(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozRvt(x uint64) (n int) {
+	return sovRvt(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *ShareMessage) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ShareMessage{`,
+		`Id:` + fmt.Sprintf("%v", this.Id) + `,`,
+		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ShareMessage_Init) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ShareMessage_Init{`,
+		`Init:` + strings.Replace(fmt.Sprintf("%v", this.Init), "InitMessage", "InitMessage", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ShareMessage_Render) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ShareMessage_Render{`,
+		`Render:` + strings.Replace(fmt.Sprintf("%v", this.Render), "RenderMessage", "RenderMessage", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ShareMessage_Event) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ShareMessage_Event{`,
+		`Event:` + strings.Replace(fmt.Sprintf("%v", this.Event), "EventMessage", "EventMessage", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ShareMessage_Exit) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ShareMessage_Exit{`,
+		`Exit:` + strings.Replace(fmt.Sprintf("%v", this.Exit), "ExitMessage", "ExitMessage", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ShareMessage_Bell) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ShareMessage_Bell{`,
+		`Bell:` + strings.Replace(fmt.Sprintf("%v", this.Bell), "BellMessage", "BellMessage", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ShareMessage_Leave) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ShareMessage_Leave{`,
+		`Leave:` + strings.Replace(fmt.Sprintf("%v", this.Leave), "LeaveMessage", "LeaveMessage", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ShareMessage_Ack) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ShareMessage_Ack{`,
+		`Ack:` + strings.Replace(fmt.Sprintf("%v", this.Ack), "AckMessage", "AckMessage", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ShareMessage_Ping) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ShareMessage_Ping{`,
+		`Ping:` + strings.Replace(fmt.Sprintf("%v", this.Ping), "PingMessage", "PingMessage", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ShareMessage_Clipboard) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ShareMessage_Clipboard{`,
+		`Clipboard:` + strings.Replace(fmt.Sprintf("%v", this.Clipboard), "ClipboardMessage", "ClipboardMessage", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *InitMessage) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&InitMessage{`,
+		`Token:` + fmt.Sprintf("%v", this.Token) + `,`,
+		`Version:` + fmt.Sprintf("%v", this.Version) + `,`,
+		`Features:` + fmt.Sprintf("%v", this.Features) + `,`,
+		`Cols:` + fmt.Sprintf("%v", this.Cols) + `,`,
+		`Rows:` + fmt.Sprintf("%v", this.Rows) + `,`,
+		`Colors:` + fmt.Sprintf("%v", this.Colors) + `,`,
+		`MinVersion:` + fmt.Sprintf("%v", this.MinVersion) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ExitMessage) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ExitMessage{`,
+		`Title:` + fmt.Sprintf("%v", this.Title) + `,`,
+		`Code:` + fmt.Sprintf("%v", this.Code) + `,`,
+		`Signal:` + fmt.Sprintf("%v", this.Signal) + `,`,
+		`Pane:` + fmt.Sprintf("%v", this.Pane) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *BellMessage) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&BellMessage{`,
+		`Pane:` + fmt.Sprintf("%v", this.Pane) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ClipboardMessage) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ClipboardMessage{`,
+		`Pane:` + fmt.Sprintf("%v", this.Pane) + `,`,
+		`Data:` + fmt.Sprintf("%v", this.Data) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *LeaveMessage) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&LeaveMessage{`,
+		`Peer:` + fmt.Sprintf("%v", this.Peer) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RenderMessage) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForGlyphs := "[]*Glyph{"
+	for _, f := range this.Glyphs {
+		repeatedStringForGlyphs += strings.Replace(f.String(), "Glyph", "Glyph", 1) + ","
+	}
+	repeatedStringForGlyphs += "}"
+	repeatedStringForStyles := "[]*Style{"
+	for _, f := range this.Styles {
+		repeatedStringForStyles += strings.Replace(f.String(), "Style", "Style", 1) + ","
+	}
+	repeatedStringForStyles += "}"
+	repeatedStringForRuns := "[]*Run{"
+	for _, f := range this.Runs {
+		repeatedStringForRuns += strings.Replace(f.String(), "Run", "Run", 1) + ","
+	}
+	repeatedStringForRuns += "}"
+	s := strings.Join([]string{`&RenderMessage{`,
+		`Cols:` + fmt.Sprintf("%v", this.Cols) + `,`,
+		`Rows:` + fmt.Sprintf("%v", this.Rows) + `,`,
+		`Glyphs:` + repeatedStringForGlyphs + `,`,
+		`Frame:` + fmt.Sprintf("%v", this.Frame) + `,`,
+		`Styles:` + repeatedStringForStyles + `,`,
+		`Runs:` + repeatedStringForRuns + `,`,
+		`Cursor:` + strings.Replace(this.Cursor.String(), "Cursor", "Cursor", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Cursor) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Cursor{`,
+		`X:` + fmt.Sprintf("%v", this.X) + `,`,
+		`Y:` + fmt.Sprintf("%v", this.Y) + `,`,
+		`Predict:` + fmt.Sprintf("%v", this.Predict) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Style) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Style{`,
+		`Fg:` + fmt.Sprintf("%v", this.Fg) + `,`,
+		`Bg:` + fmt.Sprintf("%v", this.Bg) + `,`,
+		`AttrMask:` + fmt.Sprintf("%v", this.AttrMask) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Run) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Run{`,
+		`X:` + fmt.Sprintf("%v", this.X) + `,`,
+		`Y:` + fmt.Sprintf("%v", this.Y) + `,`,
+		`Style:` + fmt.Sprintf("%v", this.Style) + `,`,
+		`Text:` + fmt.Sprintf("%v", this.Text) + `,`,
+		`Combc:` + fmt.Sprintf("%v", this.Combc) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AckMessage) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AckMessage{`,
+		`Frame:` + fmt.Sprintf("%v", this.Frame) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PingMessage) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PingMessage{`,
+		`Seq:` + fmt.Sprintf("%v", this.Seq) + `,`,
+		`Time:` + fmt.Sprintf("%v", this.Time) + `,`,
+		`Reply:` + fmt.Sprintf("%v", this.Reply) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Glyph) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Glyph{`,
+		`X:` + fmt.Sprintf("%v", this.X) + `,`,
+		`Y:` + fmt.Sprintf("%v", this.Y) + `,`,
+		`Mainc:` + fmt.Sprintf("%v", this.Mainc) + `,`,
+		`Combc:` + fmt.Sprintf("%v", this.Combc) + `,`,
+		`Fg:` + fmt.Sprintf("%v", this.Fg) + `,`,
+		`Bg:` + fmt.Sprintf("%v", this.Bg) + `,`,
+		`AttrMask:` + fmt.Sprintf("%v", this.AttrMask) + `,`,
+		`Width:` + fmt.Sprintf("%v", this.Width) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *EventMessage) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&EventMessage{`,
+		`Event:` + fmt.Sprintf("%v", this.Event) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *EventMessage_Mouse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&EventMessage_Mouse{`,
+		`Mouse:` + strings.Replace(fmt.Sprintf("%v", this.Mouse), "EventMouse", "EventMouse", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *EventMessage_Key) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&EventMessage_Key{`,
+		`Key:` + strings.Replace(fmt.Sprintf("%v", this.Key), "EventKey", "EventKey", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *EventMessage_Resize) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&EventMessage_Resize{`,
+		`Resize:` + strings.Replace(fmt.Sprintf("%v", this.Resize), "EventResize", "EventResize", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *EventMessage_Paste) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&EventMessage_Paste{`,
+		`Paste:` + strings.Replace(fmt.Sprintf("%v", this.Paste), "EventPaste", "EventPaste", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *EventMessage_SelectPane) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&EventMessage_SelectPane{`,
+		`SelectPane:` + strings.Replace(fmt.Sprintf("%v", this.SelectPane), "EventSelectPane", "EventSelectPane", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *EventMouse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&EventMouse{`,
+		`X:` + fmt.Sprintf("%v", this.X) + `,`,
+		`Y:` + fmt.Sprintf("%v", this.Y) + `,`,
+		`ButtonMask:` + fmt.Sprintf("%v", this.ButtonMask) + `,`,
+		`ModMask:` + fmt.Sprintf("%v", this.ModMask) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *EventKey) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&EventKey{`,
+		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
+		`Rune:` + fmt.Sprintf("%v", this.Rune) + `,`,
+		`ModMask:` + fmt.Sprintf("%v", this.ModMask) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *EventResize) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&EventResize{`,
+		`Width:` + fmt.Sprintf("%v", this.Width) + `,`,
+		`Height:` + fmt.Sprintf("%v", this.Height) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *EventPaste) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&EventPaste{`,
+		`Start:` + fmt.Sprintf("%v", this.Start) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *EventSelectPane) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&EventSelectPane{`,
+		`Pane:` + fmt.Sprintf("%v", this.Pane) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringRvt(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *ShareMessage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRvt
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ShareMessage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ShareMessage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRvt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRvt
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRvt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Init", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRvt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRvt
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRvt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &InitMessage{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Message = &ShareMessage_Init{v}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Render", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRvt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRvt
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRvt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &RenderMessage{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Message = &ShareMessage_Render{v}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Event", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRvt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRvt
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRvt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &EventMessage{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Message = &ShareMessage_Event{v}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Exit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRvt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRvt
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRvt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &ExitMessage{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Message = &ShareMessage_Exit{v}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bell", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRvt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRvt
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRvt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &BellMessage{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Message = &ShareMessage_Bell{v}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Leave", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRvt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRvt
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRvt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &LeaveMessage{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Message = &ShareMessage_Leave{v}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ack", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRvt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRvt
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRvt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &AckMessage{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Message = &ShareMessage_Ack{v}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ping", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRvt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRvt
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRvt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &PingMessage{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Message = &ShareMessage_Ping{v}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Clipboard", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRvt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRvt
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRvt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &ClipboardMessage{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Message = &ShareMessage_Clipboard{v}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRvt(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRvt
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InitMessage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRvt
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InitMessage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InitMessage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRvt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRvt
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRvt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Token = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRvt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Features", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRvt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRvt
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRvt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Features = append(m.Features, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cols", wireType)
+			}
+			m.Cols = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRvt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Cols |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rows", wireType)
+			}
+			m.Rows = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRvt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Rows |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Colors", wireType)
+			}
+			m.Colors = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRvt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Colors |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinVersion", wireType)
+			}
+			m.MinVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRvt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinVersion |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRvt(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRvt
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExitMessage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRvt
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExitMessage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExitMessage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRvt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRvt
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRvt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			m.Code = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRvt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Code |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signal", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRvt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRvt
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRvt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signal = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pane", wireType)
+			}
+			m.Pane = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRvt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Pane |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRvt(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRvt
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BellMessage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRvt
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BellMessage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BellMessage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pane", wireType)
+			}
+			m.Pane = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRvt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Pane |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRvt(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRvt
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClipboardMessage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRvt
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClipboardMessage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClipboardMessage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pane", wireType)
+			}
+			m.Pane = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRvt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Pane |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRvt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRvt
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRvt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRvt(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRvt
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LeaveMessage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRvt
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LeaveMessage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LeaveMessage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Peer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRvt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRvt
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRvt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Peer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRvt(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRvt
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RenderMessage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRvt
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RenderMessage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RenderMessage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cols", wireType)
+			}
+			m.Cols = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRvt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Cols |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rows", wireType)
+			}
+			m.Rows = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRvt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Rows |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Glyphs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRvt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRvt
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRvt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Glyphs = append(m.Glyphs, &Glyph{})
+			if err := m.Glyphs[len(m.Glyphs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Frame", wireType)
+			}
+			m.Frame = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRvt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Frame |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Styles", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRvt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRvt
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRvt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Styles = append(m.Styles, &Style{})
+			if err := m.Styles[len(m.Styles)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Runs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRvt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRvt
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRvt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Runs = append(m.Runs, &Run{})
+			if err := m.Runs[len(m.Runs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cursor", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRvt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRvt
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRvt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Cursor == nil {
+				m.Cursor = &Cursor{}
+			}
+			if err := m.Cursor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRvt(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRvt
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	pv := reflect.Indirect(rv).Interface()
-	return fmt.Sprintf("*%v", pv)
+	return nil
 }
-func (m *ShareMessage) Unmarshal(dAtA []byte) error {
+func (m *Cursor) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2438,17 +6299,17 @@ func (m *ShareMessage) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ShareMessage: wiretype end group for non-group")
+			return fmt.Errorf("proto: Cursor: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ShareMessage: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Cursor: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field X", wireType)
 			}
-			var stringLen uint64
+			m.X = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRvt
@@ -2458,29 +6319,124 @@ func (m *ShareMessage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.X |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthRvt
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Y", wireType)
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			m.Y = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRvt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Y |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Predict", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRvt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Predict = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRvt(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthRvt
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Style) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRvt
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Style: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Style: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fg", wireType)
+			}
+			m.Fg = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRvt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Fg |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Init", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bg", wireType)
 			}
-			var msglen int
+			m.Bg = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRvt
@@ -2490,32 +6446,123 @@ func (m *ShareMessage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Bg |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthRvt
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AttrMask", wireType)
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			m.AttrMask = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRvt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AttrMask |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRvt(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthRvt
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &InitMessage{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Run) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRvt
 			}
-			m.Message = &ShareMessage_Init{v}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Render", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Run: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Run: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field X", wireType)
+			}
+			m.X = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRvt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.X |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Y", wireType)
+			}
+			m.Y = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRvt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Y |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Style", wireType)
 			}
-			var msglen int
+			m.Style = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRvt
@@ -2525,32 +6572,16 @@ func (m *ShareMessage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Style |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthRvt
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthRvt
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			v := &RenderMessage{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Message = &ShareMessage_Render{v}
-			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Event", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Text", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRvt
@@ -2560,27 +6591,100 @@ func (m *ShareMessage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRvt
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRvt
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &EventMessage{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Message = &ShareMessage_Event{v}
+			m.Text = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 5:
+			if wireType == 0 {
+				var v int32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowRvt
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Combc = append(m.Combc, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowRvt
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthRvt
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthRvt
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Combc) == 0 {
+					m.Combc = make([]int32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowRvt
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Combc = append(m.Combc, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Combc", wireType)
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRvt(dAtA[iNdEx:])
@@ -2602,7 +6706,7 @@ func (m *ShareMessage) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *InitMessage) Unmarshal(dAtA []byte) error {
+func (m *AckMessage) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2625,12 +6729,31 @@ func (m *InitMessage) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: InitMessage: wiretype end group for non-group")
+			return fmt.Errorf("proto: AckMessage: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: InitMessage: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AckMessage: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Frame", wireType)
+			}
+			m.Frame = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRvt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Frame |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRvt(dAtA[iNdEx:])
@@ -2652,7 +6775,7 @@ func (m *InitMessage) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RenderMessage) Unmarshal(dAtA []byte) error {
+func (m *PingMessage) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2675,17 +6798,17 @@ func (m *RenderMessage) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RenderMessage: wiretype end group for non-group")
+			return fmt.Errorf("proto: PingMessage: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RenderMessage: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PingMessage: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Cols", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Seq", wireType)
 			}
-			m.Cols = 0
+			m.Seq = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRvt
@@ -2695,16 +6818,16 @@ func (m *RenderMessage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Cols |= int32(b&0x7F) << shift
+				m.Seq |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rows", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
 			}
-			m.Rows = 0
+			m.Time = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRvt
@@ -2714,16 +6837,16 @@ func (m *RenderMessage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Rows |= int32(b&0x7F) << shift
+				m.Time |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Glyphs", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reply", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRvt
@@ -2733,26 +6856,12 @@ func (m *RenderMessage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthRvt
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthRvt
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Glyphs = append(m.Glyphs, &Glyph{})
-			if err := m.Glyphs[len(m.Glyphs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.Reply = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRvt(dAtA[iNdEx:])
@@ -3202,6 +7311,41 @@ func (m *EventMessage) Unmarshal(dAtA []byte) error {
 			}
 			m.Event = &EventMessage_Paste{v}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SelectPane", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRvt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRvt
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRvt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &EventSelectPane{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Event = &EventMessage_SelectPane{v}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRvt(dAtA[iNdEx:])
@@ -3614,6 +7758,75 @@ func (m *EventPaste) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *EventSelectPane) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRvt
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventSelectPane: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventSelectPane: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pane", wireType)
+			}
+			m.Pane = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRvt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Pane |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRvt(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRvt
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipRvt(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -2,28 +2,95 @@ package rvt
 
 import (
 	"context"
+	"crypto/rand"
+	"encoding/hex"
 	"errors"
 	"io"
 	"sync"
+	"sync/atomic"
+	"time"
 
 	tcell "github.com/gdamore/tcell/v2"
+	gostream "github.com/libp2p/go-libp2p-gostream"
 	"github.com/rs/zerolog"
 	"golang.org/x/sync/errgroup"
+	"google.golang.org/grpc/codes"
+	// Registers gzip, so that peers can ask for the stream to be compressed.
+	_ "google.golang.org/grpc/encoding/gzip"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
 )
 
 type Screen interface {
 	tcell.Screen
 
 	Subscribe(id string, ch chan string)
+	SubscribeNotify(id string, ch chan *ShareMessage)
 	Unsubscribe(id string)
+
+	// Render returns the screen as seen by the peer id.
+	Render(id string) *RenderMessage
+}
+
+// Notifier is implemented by screens that broadcast out-of-band messages,
+// such as a pane's exit status, to every subscriber.
+type Notifier interface {
+	Notify(msg *ShareMessage)
+}
+
+// Authenticator is implemented by screens that want the identity a peer was
+// authenticated as by the transport, which unlike the ID it shares the screen
+// as can't be chosen by the peer. It is the peer's ID if the transport doesn't
+// identify peers. Authenticate is called before the peer subscribes.
+type Authenticator interface {
+	Authenticate(id, identity string)
+}
+
+// Resubscriber is implemented by screens that can give a peer that
+// reconnected with its session token the state it had before, such as its
+// color and focus, rather than treating it as a new peer.
+type Resubscriber interface {
+	// Detach stops sending to a peer that disconnected, keeping its state
+	// until it resubscribes or is unsubscribed.
+	Detach(id string)
+
+	Resubscribe(id string, ch chan string)
 }
 
+// sendQueue is how many messages may wait to be sent to a peer.
+const sendQueue = 16
+
+// ResumeGrace is how long a peer that disconnected keeps its session, so that
+// it can reconnect and resume it, before it leaves.
+const ResumeGrace = 30 * time.Second
+
 type Server struct {
+	// ConnType, if set, returns how the peer authenticated as identity is
+	// connected, such as directly or through a relay.
+	ConnType func(identity string) string
+
 	ctx    context.Context
 	screen Screen
 	id     string
 	done   chan struct{}
 	wg     sync.WaitGroup
+
+	// tokens maps the session tokens given out to the identity of the peer
+	// each was given to.
+	tokensMu sync.Mutex
+	tokens   map[string]string
+
+	// peers maps the ID of each subscribed peer to its subscription, so that
+	// a stream that ended only tears down a peer that hasn't resumed its
+	// session on another stream.
+	peersMu sync.Mutex
+	peers   map[string]*subscription
+}
+
+// subscription is a peer subscribed to the screen on a stream.
+type subscription struct {
+	identity string
+	leave    *time.Timer
 }
 
 func NewServer(ctx context.Context, screen Screen, id string) *Server {
@@ -32,9 +99,149 @@ func NewServer(ctx context.Context, screen Screen, id string) *Server {
 		screen: screen,
 		id:     id,
 		done:   make(chan struct{}),
+		tokens: make(map[string]string),
+		peers:  make(map[string]*subscription),
 	}
 }
 
+// session returns the session token of the peer with identity, and true if
+// token was a session previously given to the same peer. Otherwise a new
+// token is given out, replacing the previous one of the peer.
+func (s *Server) session(token, identity string) (string, bool, error) {
+	s.tokensMu.Lock()
+	defer s.tokensMu.Unlock()
+
+	if token != "" && s.tokens[token] == identity {
+		return token, true, nil
+	}
+
+	for t, id := range s.tokens {
+		if id == identity {
+			delete(s.tokens, t)
+		}
+	}
+
+	b := make([]byte, 16)
+	_, err := rand.Read(b)
+	if err != nil {
+		return "", false, err
+	}
+	token = hex.EncodeToString(b)
+	s.tokens[token] = identity
+	return token, false, nil
+}
+
+// forget drops the session tokens given to the peer with identity.
+func (s *Server) forget(identity string) {
+	s.tokensMu.Lock()
+	defer s.tokensMu.Unlock()
+
+	for t, id := range s.tokens {
+		if id == identity {
+			delete(s.tokens, t)
+		}
+	}
+}
+
+// subscribe subscribes the peer id to the screen on a new stream, resuming
+// its session if token is one it was given before. It returns the peer's
+// session token.
+func (s *Server) subscribe(id, identity, token string, renderCh chan string, notifyCh chan *ShareMessage) (*subscription, string, error) {
+	s.peersMu.Lock()
+	defer s.peersMu.Unlock()
+
+	token, resumed, err := s.session(token, identity)
+	if err != nil {
+		return nil, "", err
+	}
+
+	if prev, ok := s.peers[id]; ok && prev.leave != nil {
+		prev.leave.Stop()
+	}
+	sub := &subscription{identity: identity}
+	s.peers[id] = sub
+
+	if a, ok := s.screen.(Authenticator); ok {
+		a.Authenticate(id, identity)
+	}
+
+	r, ok := s.screen.(Resubscriber)
+	if resumed && ok {
+		zerolog.Ctx(s.ctx).Info().Str("id", id).Msg("Resumed screen subscriber")
+		r.Resubscribe(id, renderCh)
+	} else {
+		zerolog.Ctx(s.ctx).Info().Str("id", id).Msg("New screen subscriber")
+		s.screen.Subscribe(id, renderCh)
+	}
+	s.screen.SubscribeNotify(id, notifyCh)
+	return sub, token, nil
+}
+
+// disconnect tears down the subscription of the peer id once its stream has
+// ended. Screens that can resume sessions keep the peer's state for
+// ResumeGrace before it leaves.
+func (s *Server) disconnect(id string, sub *subscription) {
+	s.peersMu.Lock()
+	defer s.peersMu.Unlock()
+
+	if s.peers[id] != sub {
+		// The peer resumed its session on another stream.
+		return
+	}
+
+	if r, ok := s.screen.(Resubscriber); ok {
+		zerolog.Ctx(s.ctx).Info().Str("id", id).Msg("Detached screen subscriber")
+		r.Detach(id)
+		sub.leave = time.AfterFunc(ResumeGrace, func() {
+			s.peersMu.Lock()
+			defer s.peersMu.Unlock()
+			if s.peers[id] == sub {
+				s.leave(id, sub)
+			}
+		})
+		return
+	}
+	s.leave(id, sub)
+}
+
+// leave unsubscribes the peer id and tells the remaining peers it left. It
+// must be called with peersMu held.
+func (s *Server) leave(id string, sub *subscription) {
+	zerolog.Ctx(s.ctx).Info().Str("id", id).Msg("Screen subscriber left")
+	delete(s.peers, id)
+	s.forget(sub.identity)
+	s.screen.Unsubscribe(id)
+
+	if n, ok := s.screen.(Notifier); ok {
+		n.Notify(&ShareMessage{
+			Id: s.id,
+			Message: &ShareMessage_Leave{
+				Leave: &LeaveMessage{Peer: id},
+			},
+		})
+	}
+}
+
+// reportStats reports the stats of the peer id, authenticated as identity, to
+// screens that show them.
+func (s *Server) reportStats(id, identity string, m *meter, pace *pacer, now time.Time, bytes, frames uint64) {
+	r, ok := s.screen.(StatsReporter)
+	if !ok {
+		return
+	}
+
+	var stats PeerStats
+	stats.BytesPerSec, stats.FramesPerSec = m.rates(now, bytes, frames)
+	stats.RTT = m.srtt
+	if stats.RTT == 0 {
+		stats.RTT = pace.srtt
+	}
+	if s.ConnType != nil && identity != "" {
+		stats.Conn = s.ConnType(identity)
+	}
+	r.ReportStats(id, stats)
+}
+
 func (s *Server) Cancel() {
 	close(s.done)
 }
@@ -47,6 +254,15 @@ func (s *Server) Close() error {
 func (s *Server) Share(srv Screen_ShareServer) error {
 	ctx := s.ctx
 
+	// The address of a libp2p stream is the peer ID the other end was
+	// authenticated as. Peers identify themselves by the ID in each message,
+	// which must be the same so that a peer can't subscribe, focus or type
+	// as another.
+	var authID string
+	if p, ok := peer.FromContext(srv.Context()); ok && p.Addr != nil && p.Addr.Network() == gostream.Network {
+		authID = p.Addr.String()
+	}
+
 	s.wg.Add(1)
 	recvMsgs := make(chan *ShareMessage)
 	go func() {
@@ -64,13 +280,19 @@ func (s *Server) Share(srv Screen_ShareServer) error {
 			select {
 			case <-s.done:
 				return
+			case <-srv.Context().Done():
+				return
 			case recvMsgs <- shareMsg:
 			}
 		}
 	}()
 
+	// Messages wait in sendMsgs while earlier ones are sent, which holds back
+	// further frames. The bytes and frames sent are counted for the peer's
+	// stats.
+	var sentBytes, sentFrames uint64
 	s.wg.Add(1)
-	sendMsgs := make(chan *ShareMessage)
+	sendMsgs := make(chan *ShareMessage, sendQueue)
 	go func() {
 		defer s.wg.Done()
 		for msg := range sendMsgs {
@@ -79,14 +301,33 @@ func (s *Server) Share(srv Screen_ShareServer) error {
 				zerolog.Ctx(ctx).Error().Err(err).Msg("failed to send share message")
 				continue
 			}
+			atomic.AddUint64(&sentBytes, uint64(msg.Size()))
+			if _, ok := msg.Message.(*ShareMessage_Render); ok {
+				atomic.AddUint64(&sentFrames, 1)
+			}
 		}
 	}()
 
 	eg := new(errgroup.Group)
 
-	var subscribeOnce sync.Once
+	var (
+		sub      *subscription
+		peerID   string
+		peerInit *InitMessage
+
+		// downsampler maps colors to those the peer's terminal can show.
+		downsampler *Downsampler
+	)
 	renderCh := make(chan string, 16)
+	notifyCh := make(chan *ShareMessage, 16)
+	initCh := make(chan *ShareMessage, 1)
+	ackCh := make(chan uint64, 16)
+	pingCh := make(chan *PingMessage, 16)
+
+	// recvDone is closed once the peer stops sending, which ends the stream.
+	recvDone := make(chan struct{})
 	eg.Go(func() error {
+		defer close(recvDone)
 		for {
 			var shareMsg *ShareMessage
 			select {
@@ -97,19 +338,71 @@ func (s *Server) Share(srv Screen_ShareServer) error {
 			if shareMsg == nil {
 				return nil
 			}
+			if shareMsg.Id == s.id || (authID != "" && shareMsg.Id != authID) {
+				return status.Errorf(codes.PermissionDenied, "peer %s may not send messages as %q", authID, shareMsg.Id)
+			}
+			if sub != nil && shareMsg.Id != peerID {
+				return status.Errorf(codes.PermissionDenied, "peer %s may not send messages as %q", peerID, shareMsg.Id)
+			}
 
 			switch msg := shareMsg.Message.(type) {
 			case *ShareMessage_Init:
-				subscribeOnce.Do(func() {
-					zerolog.Ctx(ctx).Info().Str("id", shareMsg.Id).Msg("New screen subscriber")
-					s.screen.Subscribe(shareMsg.Id, renderCh)
-					renderCh <- "init"
-				})
+				if sub != nil {
+					continue
+				}
+
+				err := msg.Init.CheckVersion()
+				if err != nil {
+					return status.Error(codes.FailedPrecondition, err.Error())
+				}
+				peerInit = msg.Init
+				downsampler = NewDownsampler(int(peerInit.Colors))
+
+				peerID = shareMsg.Id
+				identity := authID
+				if identity == "" {
+					identity = peerID
+				}
+
+				var token string
+				sub, token, err = s.subscribe(peerID, identity, msg.Init.Token, renderCh, notifyCh)
+				if err != nil {
+					return err
+				}
+
+				initCh <- &ShareMessage{
+					Id: s.id,
+					Message: &ShareMessage_Init{
+						Init: NewInitMessage(token),
+					},
+				}
+				if peerInit.HasFeature(FeatureResize) && peerInit.Cols > 0 && peerInit.Rows > 0 {
+					s.screen.PostEvent(&RemoteEvent{
+						ID:    peerID,
+						Event: tcell.NewEventResize(int(peerInit.Cols), int(peerInit.Rows)),
+						Peer:  authID,
+					})
+				}
+				renderCh <- "init"
+			case *ShareMessage_Ack:
+				select {
+				case ackCh <- msg.Ack.Frame:
+				default:
+				}
+			case *ShareMessage_Ping:
+				select {
+				case pingCh <- msg.Ping:
+				default:
+				}
 			case *ShareMessage_Event:
+				if sub == nil {
+					continue
+				}
 				ev := ProtoToEvent(msg.Event)
 				s.screen.PostEvent(&RemoteEvent{
-					ID:    shareMsg.Id,
+					ID:    peerID,
 					Event: ev,
+					Peer:  authID,
 				})
 			}
 		}
@@ -117,20 +410,121 @@ func (s *Server) Share(srv Screen_ShareServer) error {
 
 	eg.Go(func() error {
 		defer close(sendMsgs)
+
+		// Renders are coalesced until the pacer allows the next frame, which
+		// is always rendered from the latest screen.
+		var (
+			pace    = newPacer()
+			pending bool
+			timer   *time.Timer
+			timerC  <-chan time.Time
+		)
+		defer func() {
+			if timer != nil {
+				timer.Stop()
+			}
+		}()
+
+		// Once the peer is subscribed, it is pinged and its stats reported
+		// every PingInterval.
+		var (
+			subscribed bool
+			pings      uint64
+			m          meter
+		)
+		ticker := time.NewTicker(PingInterval)
+		defer ticker.Stop()
+
 		for {
 			select {
 			case <-s.done:
 				return nil
-			case <-renderCh:
+			case <-recvDone:
+				return nil
+			case msg := <-initCh:
+				subscribed = true
+				sendMsgs <- msg
+			case _, ok := <-renderCh:
+				if !ok {
+					// The peer resumed its session on another stream.
+					return nil
+				}
+				pending = true
+			case frame := <-ackCh:
+				pace.ack(frame, time.Now())
+				// The next frame may be due sooner now.
+				if timerC != nil {
+					timer.Stop()
+					timerC = nil
+				}
+			case <-timerC:
+				timerC = nil
+			case ping := <-pingCh:
+				if ping.Reply {
+					m.sample(ping.RTT(time.Now()))
+					continue
+				}
 				sendMsgs <- &ShareMessage{
-					Id: s.id,
-					Message: &ShareMessage_Render{
-						Render: ScreenToRender(s.screen),
-					},
+					Id:      s.id,
+					Message: &ShareMessage_Ping{Ping: ping.Pong()},
+				}
+			case now := <-ticker.C:
+				if !subscribed {
+					continue
+				}
+				if peerInit.HasFeature(FeaturePing) {
+					pings++
+					sendMsgs <- &ShareMessage{
+						Id:      s.id,
+						Message: &ShareMessage_Ping{Ping: NewPingMessage(pings, now)},
+					}
 				}
+				s.reportStats(peerID, authID, &m, pace, now, atomic.LoadUint64(&sentBytes), atomic.LoadUint64(&sentFrames))
+			case msg, ok := <-notifyCh:
+				if !ok {
+					notifyCh = nil
+					continue
+				}
+				if _, ok := msg.Message.(*ShareMessage_Clipboard); ok && !peerInit.HasFeature(FeatureClipboard) {
+					continue
+				}
+				sendMsgs <- msg
+			}
+
+			// Frames are only sent once the host's InitMessage has been.
+			if !pending || timerC != nil || !subscribed {
+				continue
+			}
+
+			now := time.Now()
+			if d := pace.delay(now, len(sendMsgs)); d > 0 {
+				timer = time.NewTimer(d)
+				timerC = timer.C
+				continue
+			}
+
+			render := *s.screen.Render(peerID)
+			render.Frame = pace.sent(now)
+			render.Styles = downsampler.Styles(render.Styles)
+			if !peerInit.HasFeature(FeatureCursor) {
+				render.Cursor = nil
+			}
+			if !peerInit.HasFeature(FeatureRuns) {
+				render = *RunsToGlyphs(&render)
+			}
+			pending = false
+			sendMsgs <- &ShareMessage{
+				Id: s.id,
+				Message: &ShareMessage_Render{
+					Render: &render,
+				},
 			}
 		}
 	})
 
-	return eg.Wait()
+	err := eg.Wait()
+	if sub != nil {
+		s.disconnect(peerID, sub)
+	}
+	return err
 }
@@ -0,0 +1,206 @@
+package rvt
+
+import (
+	"context"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	tcell "github.com/gdamore/tcell/v2"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// testScreen is a simulated screen that records the peers subscribed to it
+// and the events they post.
+type testScreen struct {
+	tcell.SimulationScreen
+
+	mu         sync.Mutex
+	subs       map[string]bool
+	identities map[string]string
+	events     []*RemoteEvent
+}
+
+func newTestScreen(t *testing.T) *testScreen {
+	s := tcell.NewSimulationScreen("")
+	err := s.Init()
+	if err != nil {
+		t.Fatal(err)
+	}
+	s.SetSize(20, 5)
+	return &testScreen{
+		SimulationScreen: s,
+		subs:             make(map[string]bool),
+		identities:       make(map[string]string),
+	}
+}
+
+func (s *testScreen) Authenticate(id, identity string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.identities[id] = identity
+}
+
+// Subscribe records id as subscribed, as the identity it was authenticated
+// as.
+func (s *testScreen) Subscribe(id string, ch chan string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.subs[s.identities[id]] = true
+}
+
+func (s *testScreen) SubscribeNotify(id string, ch chan *ShareMessage) {}
+
+func (s *testScreen) Unsubscribe(id string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.subs, id)
+}
+
+func (s *testScreen) Render(id string) *RenderMessage {
+	return ScreenToRender(s)
+}
+
+func (s *testScreen) PostEvent(ev tcell.Event) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if rev, ok := ev.(*RemoteEvent); ok {
+		s.events = append(s.events, rev)
+	}
+	return nil
+}
+
+func (s *testScreen) subscribed(id string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.subs[id]
+}
+
+func (s *testScreen) eventIDs() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var ids []string
+	for _, ev := range s.events {
+		ids = append(ids, ev.ID)
+	}
+	return ids
+}
+
+// serve serves screen as the host "host" and returns a client connected to
+// it.
+func serve(t *testing.T, screen Screen) ScreenClient {
+	l := bufconn.Listen(1 << 20)
+	srv := NewServer(context.Background(), screen, "host")
+	g := grpc.NewServer()
+	RegisterScreenServer(g, srv)
+	go g.Serve(l)
+	t.Cleanup(func() {
+		srv.Cancel()
+		g.Stop()
+	})
+
+	conn, err := grpc.Dial("bufconn", grpc.WithInsecure(), grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+		return l.Dial()
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	return NewScreenClient(conn)
+}
+
+// share opens a stream and subscribes to it as id, returning the stream once
+// the host has answered.
+func share(t *testing.T, ctx context.Context, client ScreenClient, id string) Screen_ShareClient {
+	stream, err := client.Share(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = stream.Send(&ShareMessage{Id: id, Message: &ShareMessage_Init{Init: NewInitMessage("")}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return stream
+}
+
+// recvError receives from stream until it ends and returns its status code.
+func recvError(t *testing.T, stream Screen_ShareClient) codes.Code {
+	for {
+		_, err := stream.Recv()
+		if err != nil {
+			return status.Code(err)
+		}
+	}
+}
+
+func TestShareRejectsHostID(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	screen := newTestScreen(t)
+	stream := share(t, ctx, serve(t, screen), "host")
+	if code := recvError(t, stream); code != codes.PermissionDenied {
+		t.Fatalf("expected %s, got %s", codes.PermissionDenied, code)
+	}
+	if screen.subscribed("host") {
+		t.Fatal("peer subscribed with the host's ID")
+	}
+}
+
+func TestShareRejectsChangedID(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	screen := newTestScreen(t)
+	stream := share(t, ctx, serve(t, screen), "alice")
+
+	// Wait for the host's Init, so that alice is subscribed.
+	msg, err := stream.Recv()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := msg.Message.(*ShareMessage_Init); !ok {
+		t.Fatalf("expected Init, got %T", msg.Message)
+	}
+
+	key := EventToProto(tcell.NewEventKey(tcell.KeyRune, 'x', tcell.ModNone))
+	for _, id := range []string{"alice", "bob"} {
+		err = stream.Send(&ShareMessage{Id: id, Message: &ShareMessage_Event{Event: key}})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	if code := recvError(t, stream); code != codes.PermissionDenied {
+		t.Fatalf("expected %s, got %s", codes.PermissionDenied, code)
+	}
+
+	for _, id := range screen.eventIDs() {
+		if id != "alice" {
+			t.Fatalf("event posted as %q", id)
+		}
+	}
+	if screen.subscribed("bob") {
+		t.Fatal("bob subscribed through alice's stream")
+	}
+}
+
+func TestShareAuthenticatesBeforeSubscribing(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	screen := newTestScreen(t)
+	stream := share(t, ctx, serve(t, screen), "alice")
+	_, err := stream.Recv()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// bufconn doesn't identify peers, so alice is known by the ID she gave.
+	if !screen.subscribed("alice") {
+		t.Fatal("alice wasn't authenticated before subscribing")
+	}
+}
@@ -1,12 +1,35 @@
 package rvt
 
 import (
+	"strings"
+	"time"
+
 	"github.com/gdamore/tcell/v2"
 )
 
 type RemoteEvent struct {
 	ID string
 	tcell.Event
+
+	// Peer is the ID the sender was authenticated as by the transport,
+	// which unlike ID can't be chosen by the sender. It is empty if the
+	// transport doesn't identify peers.
+	Peer string
+}
+
+// SelectPaneEvent asks the host to move the sender's focus onto the pane
+// with the given stable ID.
+type SelectPaneEvent struct {
+	t    time.Time
+	Pane int
+}
+
+func NewSelectPaneEvent(pane int) *SelectPaneEvent {
+	return &SelectPaneEvent{t: time.Now(), Pane: pane}
+}
+
+func (ev *SelectPaneEvent) When() time.Time {
+	return ev.t
 }
 
 func EventToProto(event tcell.Event) *EventMessage {
@@ -43,6 +66,12 @@ func EventToProto(event tcell.Event) *EventMessage {
 				Start: evt.Start(),
 			},
 		}}
+	case *SelectPaneEvent:
+		return &EventMessage{Event: &EventMessage_SelectPane{
+			SelectPane: &EventSelectPane{
+				Pane: int32(evt.Pane),
+			},
+		}}
 	}
 	return nil
 }
@@ -69,46 +98,156 @@ func ProtoToEvent(msg *EventMessage) tcell.Event {
 		)
 	case *EventMessage_Paste:
 		return tcell.NewEventPaste(event.Paste.Start)
+	case *EventMessage_SelectPane:
+		return NewSelectPaneEvent(int(event.SelectPane.Pane))
 	}
 	return nil
 }
 
+// ScreenToRender encodes the cells of s as runs of text, with the styles of
+// the runs in a style table, since most cells share their style with the
+// cells next to them.
 func ScreenToRender(s tcell.Screen) *RenderMessage {
 	cols, rows := s.Size()
 
-	var glyphs []*Glyph
-	for y := 0; y < rows; y++ {
-		for x := 0; x < cols; x++ {
-			mainc, combc, style, width := s.GetContent(x, y)
+	msg := &RenderMessage{
+		Cols: int32(cols),
+		Rows: int32(rows),
+	}
+	styles := make(map[tcell.Style]int32)
+	styleIndex := func(style tcell.Style) int32 {
+		i, ok := styles[style]
+		if !ok {
 			fg, bg, attr := style.Decompose()
-
-			var combi []int32
-			for _, c := range combc {
-				combi = append(combi, int32(c))
-			}
-
-			glyphs = append(glyphs, &Glyph{
-				X:        int32(x),
-				Y:        int32(y),
-				Mainc:    int32(mainc),
-				Combc:    combi,
+			i = int32(len(msg.Styles))
+			styles[style] = i
+			msg.Styles = append(msg.Styles, &Style{
 				Fg:       uint64(fg),
 				Bg:       uint64(bg),
 				AttrMask: int32(attr),
-				Width:    int32(width),
 			})
 		}
+		return i
+	}
+
+	var text strings.Builder
+	for y := 0; y < rows; y++ {
+		var run *Run
+		flush := func() {
+			if run != nil {
+				run.Text = text.String()
+				msg.Runs = append(msg.Runs, run)
+				run = nil
+			}
+			text.Reset()
+		}
+
+		for x := 0; x < cols; x++ {
+			mainc, combc, style, _ := s.GetContent(x, y)
+			i := styleIndex(style)
+
+			if len(combc) > 0 {
+				flush()
+				var combi []int32
+				for _, c := range combc {
+					combi = append(combi, int32(c))
+				}
+				msg.Runs = append(msg.Runs, &Run{
+					X:     int32(x),
+					Y:     int32(y),
+					Style: i,
+					Text:  string(mainc),
+					Combc: combi,
+				})
+				continue
+			}
+
+			if run == nil || run.Style != i {
+				flush()
+				run = &Run{X: int32(x), Y: int32(y), Style: i}
+			}
+			text.WriteRune(mainc)
+		}
+		flush()
 	}
+	return msg
+}
 
-	return &RenderMessage{
-		Cols:   int32(cols),
-		Rows:   int32(rows),
-		Glyphs: glyphs,
+// RunsToGlyphs returns msg with its runs expanded into one glyph per cell,
+// for peers that don't support FeatureRuns.
+func RunsToGlyphs(msg *RenderMessage) *RenderMessage {
+	glyphs := &RenderMessage{
+		Cols:  msg.Cols,
+		Rows:  msg.Rows,
+		Frame: msg.Frame,
 	}
+	for _, run := range msg.Runs {
+		var style Style
+		if int(run.Style) < len(msg.Styles) {
+			style = *msg.Styles[run.Style]
+		}
+
+		x := run.X
+		for _, r := range run.Text {
+			glyphs.Glyphs = append(glyphs.Glyphs, &Glyph{
+				X:        x,
+				Y:        run.Y,
+				Mainc:    int32(r),
+				Combc:    run.Combc,
+				Fg:       style.Fg,
+				Bg:       style.Bg,
+				AttrMask: style.AttrMask,
+			})
+			x++
+		}
+	}
+	return glyphs
 }
 
+// RenderToScreen draws msg onto s and shows it.
 func RenderToScreen(msg *RenderMessage, s tcell.Screen) {
+	DrawRender(msg, s)
+	s.Show()
+}
+
+// DrawRender draws msg onto s without showing it, so that more can be drawn
+// over it first.
+func DrawRender(msg *RenderMessage, s tcell.Screen) {
 	cols, rows := s.Size()
+
+	styles := make([]tcell.Style, len(msg.Styles))
+	for i, st := range msg.Styles {
+		styles[i] = tcell.StyleDefault.
+			Foreground(tcell.Color(st.Fg)).
+			Background(tcell.Color(st.Bg)).
+			Attributes(tcell.AttrMask(st.AttrMask))
+	}
+
+	for _, run := range msg.Runs {
+		y := int(run.Y)
+		if y >= rows {
+			continue
+		}
+
+		var style tcell.Style
+		if int(run.Style) < len(styles) {
+			style = styles[run.Style]
+		}
+		var combc []rune
+		for _, c := range run.Combc {
+			combc = append(combc, rune(c))
+		}
+
+		x := int(run.X)
+		for _, r := range run.Text {
+			if x >= cols {
+				break
+			}
+			s.SetContent(x, y, r, combc, style)
+			x++
+		}
+	}
+
 	for _, glyph := range msg.Glyphs {
 		x, y := int(glyph.X), int(glyph.Y)
 		if x >= cols || y >= rows {
@@ -128,5 +267,4 @@ func RenderToScreen(msg *RenderMessage, s tcell.Screen) {
 
 		s.SetContent(x, y, mainc, combc, style)
 	}
-	s.Show()
 }
@@ -0,0 +1,92 @@
+package rvt
+
+import "fmt"
+
+// ProtocolID is the libp2p protocol the screen is shared on. It stays the
+// same across versions of the protocol, which peers agree on in their
+// InitMessage instead.
+const ProtocolID = "/ptmux/1.0.0"
+
+// Versions of the protocol. Version 1 is spoken by peers that predate
+// versioning and send no version. Two peers speak the older of their
+// versions, as long as it isn't older than either supports, which each side
+// gives as the MinVersion of its InitMessage.
+const (
+	ProtocolVersion    = 2
+	MinProtocolVersion = 1
+)
+
+// Features a peer may support, which the other side only relies on if they
+// are given in the peer's InitMessage.
+const (
+	// FeatureRuns sends frames as runs of text with a style table rather
+	// than one glyph per cell.
+	FeatureRuns = "runs"
+
+	// FeatureAck acknowledges frames, so that they are paced by the
+	// round-trip time.
+	FeatureAck = "ack"
+
+	// FeatureGzip compresses the stream with gzip.
+	FeatureGzip = "gzip"
+
+	// FeatureResize sizes the peer's view from the size in its InitMessage.
+	FeatureResize = "resize"
+
+	// FeaturePing answers pings, so that the round-trip time is measured
+	// even while no frames are sent.
+	FeaturePing = "ping"
+
+	// FeatureCursor sends the cursor of the pane the peer has focused with
+	// each frame, so that the peer can echo keys before the host does.
+	FeatureCursor = "cursor"
+
+	// FeatureClipboard sends a ClipboardMessage when a pane sets the
+	// clipboard.
+	FeatureClipboard = "clipboard"
+)
+
+// Features are the features supported by this version.
+var Features = []string{FeatureRuns, FeatureAck, FeatureGzip, FeatureResize, FeaturePing, FeatureCursor, FeatureClipboard}
+
+// NewInitMessage returns an InitMessage for this version with token.
+func NewInitMessage(token string) *InitMessage {
+	return &InitMessage{
+		Token:      token,
+		Version:    ProtocolVersion,
+		MinVersion: MinProtocolVersion,
+		Features:   Features,
+	}
+}
+
+// ProtocolVersion returns the version of the protocol the sender speaks.
+func (m *InitMessage) ProtocolVersion() uint32 {
+	if m.Version == 0 {
+		return 1
+	}
+	return m.Version
+}
+
+// HasFeature returns true if the sender supports feature.
+func (m *InitMessage) HasFeature(feature string) bool {
+	for _, f := range m.Features {
+		if f == feature {
+			return true
+		}
+	}
+	return false
+}
+
+// CheckVersion returns an error if the sender speaks a version of the
+// protocol older than this version supports, or only versions newer than
+// this version speaks.
+func (m *InitMessage) CheckVersion() error {
+	v := m.ProtocolVersion()
+	if v < MinProtocolVersion {
+		return fmt.Errorf("protocol version %d is no longer supported, upgrade to version %d or newer", v, MinProtocolVersion)
+	}
+	if m.MinVersion > ProtocolVersion {
+		return fmt.Errorf("protocol version %d or newer is required, but only version %d is supported here", m.MinVersion, ProtocolVersion)
+	}
+	return nil
+}
@@ -0,0 +1,52 @@
+package rvt
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestCheckVersion(t *testing.T) {
+	for _, tc := range []struct {
+		name string
+		init *InitMessage
+		err  string
+	}{
+		{"this version", NewInitMessage(""), ""},
+		{"unversioned", &InitMessage{}, ""},
+		{"oldest supported", &InitMessage{Version: MinProtocolVersion}, ""},
+		{"newer", &InitMessage{Version: ProtocolVersion + 1, MinVersion: MinProtocolVersion}, ""},
+		{"newer speaking this version", &InitMessage{Version: ProtocolVersion + 1, MinVersion: ProtocolVersion}, ""},
+		{"requires newer", &InitMessage{Version: ProtocolVersion + 2, MinVersion: ProtocolVersion + 1}, "or newer is required"},
+	} {
+		err := tc.init.CheckVersion()
+		if tc.err == "" {
+			if err != nil {
+				t.Errorf("%s: unexpected error: %s", tc.name, err)
+			}
+			continue
+		}
+		if err == nil || !strings.Contains(err.Error(), tc.err) {
+			t.Errorf("%s: expected error containing %q, got %v", tc.name, tc.err, err)
+		}
+	}
+}
+
+func TestHasFeature(t *testing.T) {
+	for _, tc := range []struct {
+		init    *InitMessage
+		feature string
+		want    bool
+	}{
+		{NewInitMessage(""), FeatureRuns, true},
+		{NewInitMessage(""), FeatureCursor, true},
+		{NewInitMessage(""), FeatureClipboard, true},
+		{NewInitMessage(""), "teleport", false},
+		{&InitMessage{}, FeatureRuns, false},
+		{&InitMessage{Features: []string{FeaturePing}}, FeaturePing, true},
+		{&InitMessage{Features: []string{FeaturePing}}, FeatureAck, false},
+	} {
+		if got := tc.init.HasFeature(tc.feature); got != tc.want {
+			t.Errorf("%v has %s: expected %t, got %t", tc.init.Features, tc.feature, tc.want, got)
+		}
+	}
+}
@@ -0,0 +1,79 @@
+package ui
+
+import (
+	"fmt"
+	"time"
+
+	tcell "github.com/gdamore/tcell/v2"
+	"github.com/hinshun/ptmux/pkg/audit"
+	"github.com/hinshun/ptmux/rvt"
+	"github.com/hinshun/ptmux/ui/widgets/pane"
+)
+
+// Audit logs an event sent by a remote peer once it has been applied to the
+// pane p, which may be nil. It is called from the main loop.
+func (s *screen) Audit(ev *rvt.RemoteEvent, p *pane.Widget) {
+	if s.audit == nil {
+		return
+	}
+
+	e := auditEntry(ev)
+	if e.Event == "" {
+		return
+	}
+	if p != nil {
+		e.Pane = fmt.Sprintf("%%%d", p.ID())
+		if e.Keys != "" && s.audit.Redact() && p.HidesInput() {
+			e.Keys = ""
+			e.Redacted = true
+		}
+	}
+
+	s.audit.Write(e)
+}
+
+// auditEntry describes ev for the audit log. The kind of event is left empty
+// for events that aren't logged.
+func auditEntry(ev *rvt.RemoteEvent) audit.Entry {
+	e := audit.Entry{
+		Time: time.Now().UTC(),
+		Peer: ev.Peer,
+	}
+	if e.Peer == "" {
+		e.Peer = ev.ID
+	} else if ev.ID != ev.Peer {
+		e.Claimed = ev.ID
+	}
+
+	switch evt := ev.Event.(type) {
+	case *tcell.EventKey:
+		e.Event = "key"
+		e.Keys = keyName(evt)
+	case *tcell.EventMouse:
+		x, y := evt.Position()
+		e.Event = "mouse"
+		e.Detail = fmt.Sprintf("%d,%d buttons=%d", x, y, evt.Buttons())
+	case *tcell.EventPaste:
+		e.Event = "paste"
+		e.Detail = "end"
+		if evt.Start() {
+			e.Detail = "start"
+		}
+	case *tcell.EventResize:
+		cols, rows := evt.Size()
+		e.Event = "resize"
+		e.Detail = fmt.Sprintf("%dx%d", cols, rows)
+	case *rvt.SelectPaneEvent:
+		e.Event = "select-pane"
+	}
+	return e
+}
+
+// keyName returns the key typed, as the character itself if it has no
+// modifiers, or as tcell names it otherwise, e.g. "Ctrl+C" or "Enter".
+func keyName(ev *tcell.EventKey) string {
+	if ev.Key() == tcell.KeyRune && ev.Modifiers() == 0 {
+		return string(ev.Rune())
+	}
+	return ev.Name()
+}
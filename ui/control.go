@@ -0,0 +1,331 @@
+package ui
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gcla/gowid"
+	"github.com/hinshun/ptmux/control"
+	"github.com/hinshun/ptmux/pkg/vt"
+	"github.com/hinshun/ptmux/ui/widgets/pane"
+)
+
+// controlServer serves the control API by running each request on the main
+// loop, acting as the host.
+type controlServer struct {
+	ui   *UI
+	name string
+}
+
+var _ control.ControlServer = (*controlServer)(nil)
+
+// ControlServer returns the control API of the session name.
+func (ui *UI) ControlServer(name string) control.ControlServer {
+	return &controlServer{
+		ui:   ui,
+		name: name,
+	}
+}
+
+func (cs *controlServer) run(ctx context.Context, f func(app gowid.IApp) error) error {
+	errCh := make(chan error, 1)
+	err := cs.ui.app.Run(gowid.RunFunction(func(app gowid.IApp) {
+		errCh <- f(cs.ui.screen.peerstyle.Context(app))
+	}))
+	if err != nil {
+		return err
+	}
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case err := <-errCh:
+		return err
+	}
+}
+
+func (cs *controlServer) paneInfo(p *pane.Widget) *control.Pane {
+	sess := cs.ui.session
+	win := sess.WindowOf(p)
+	cols, rows := p.Size()
+	st := p.State()
+	var pipe string
+	if p.Pipe().Piping() {
+		pipe = p.Pipe().String()
+	}
+	return &control.Pane{
+		Id:      int32(p.ID()),
+		Window:  int32(sess.WindowIndex(win)),
+		Index:   int32(win.PaneIndex(p)),
+		Title:   p.Title(),
+		Width:   int32(cols),
+		Height:  int32(rows),
+		Active:  win.FocusedPane(cs.ui.id) == p,
+		Dead:    p.Exited(),
+		Status:  p.Status(),
+		Command: st.Command,
+		Cwd:     st.Cwd,
+		Alerts:  p.Alerts().String(),
+		Options: p.Options(),
+		Pipe:    pipe,
+	}
+}
+
+func (cs *controlServer) ListSessions(ctx context.Context, req *control.ListSessionsRequest) (*control.ListSessionsResponse, error) {
+	resp := &control.ListSessionsResponse{}
+	err := cs.run(ctx, func(app gowid.IApp) error {
+		resp.Sessions = append(resp.Sessions, &control.Session{
+			Name:    cs.name,
+			Windows: int32(len(cs.ui.session.Windows())),
+			Peers:   cs.ui.screen.peerstyle.IDs(),
+		})
+		return nil
+	})
+	return resp, err
+}
+
+func (cs *controlServer) ListClients(ctx context.Context, req *control.ListClientsRequest) (*control.ListClientsResponse, error) {
+	resp := &control.ListClientsResponse{}
+	err := cs.run(ctx, func(app gowid.IApp) error {
+		stats := cs.ui.screen.RenderStats()
+		for _, id := range cs.ui.screen.peerstyle.IDs() {
+			if id == cs.ui.id {
+				continue
+			}
+			st, ok := stats[id]
+			resp.Clients = append(resp.Clients, &control.Client{
+				Peer:      id,
+				Renders:   st.Published,
+				Delivered: st.Delivered,
+				Coalesced: st.Coalesced,
+				Dropped:   st.Dropped,
+				Detached:  !ok,
+			})
+		}
+		return nil
+	})
+	return resp, err
+}
+
+func (cs *controlServer) ListWindows(ctx context.Context, req *control.ListWindowsRequest) (*control.ListWindowsResponse, error) {
+	resp := &control.ListWindowsResponse{}
+	err := cs.run(ctx, func(app gowid.IApp) error {
+		sess := cs.ui.session
+		for i, win := range sess.Windows() {
+			resp.Windows = append(resp.Windows, &control.Window{
+				Index:  int32(i),
+				Id:     int32(win.ID()),
+				Layout: win.Layout(),
+				Panes:  int32(len(win.Panes())),
+				Active: i == sess.CurrentWindow(cs.ui.id),
+			})
+		}
+		return nil
+	})
+	return resp, err
+}
+
+func (cs *controlServer) ListPanes(ctx context.Context, req *control.ListPanesRequest) (*control.ListPanesResponse, error) {
+	resp := &control.ListPanesResponse{}
+	err := cs.run(ctx, func(app gowid.IApp) error {
+		sess := cs.ui.session
+		windows := sess.Windows()
+		if !req.All {
+			i := int(req.Window)
+			if i < 0 {
+				i = sess.CurrentWindow(cs.ui.id)
+			}
+			if i >= len(windows) {
+				return fmt.Errorf("no window %d", i)
+			}
+			windows = windows[i : i+1]
+		}
+
+		for _, win := range windows {
+			for _, p := range win.Panes() {
+				resp.Panes = append(resp.Panes, cs.paneInfo(p))
+			}
+		}
+		return nil
+	})
+	return resp, err
+}
+
+func (cs *controlServer) SendKeys(ctx context.Context, req *control.SendKeysRequest) (*control.SendKeysResponse, error) {
+	err := cs.run(ctx, func(app gowid.IApp) error {
+		p, err := cs.ui.session.FindPane(cs.ui.id, req.Target)
+		if err != nil {
+			return err
+		}
+		return p.SendKeys(control.Keys(req.Keys, req.Literal))
+	})
+	return &control.SendKeysResponse{}, err
+}
+
+func (cs *controlServer) CapturePane(ctx context.Context, req *control.CapturePaneRequest) (*control.CapturePaneResponse, error) {
+	var p *pane.Widget
+	err := cs.run(ctx, func(app gowid.IApp) error {
+		var err error
+		p, err = cs.ui.session.FindPane(cs.ui.id, req.Target)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// The terminal is locked while it is captured, so this doesn't need to
+	// hold up the main loop.
+	return &control.CapturePaneResponse{
+		Content: p.Capture(req.Escapes, req.History),
+	}, nil
+}
+
+func (cs *controlServer) SplitPane(ctx context.Context, req *control.SplitPaneRequest) (*control.SplitPaneResponse, error) {
+	resp := &control.SplitPaneResponse{}
+	err := cs.run(ctx, func(app gowid.IApp) error {
+		sess := cs.ui.session
+		p, err := sess.FindPane(cs.ui.id, req.Target)
+		if err != nil {
+			return err
+		}
+
+		np := pane.New(cs.ui.id, cs.ui.id, vt.Command{
+			Args: req.Command,
+			Dir:  req.Cwd,
+		})
+		sess.WindowOf(p).InsertPane(cs.ui.id, p, np, req.Vertical, app)
+		resp.Pane = cs.paneInfo(np)
+		return nil
+	})
+	return resp, err
+}
+
+func (cs *controlServer) KillPane(ctx context.Context, req *control.KillPaneRequest) (*control.KillPaneResponse, error) {
+	err := cs.run(ctx, func(app gowid.IApp) error {
+		sess := cs.ui.session
+		p, err := sess.FindPane(cs.ui.id, req.Target)
+		if err != nil {
+			return err
+		}
+		sess.WindowOf(p).KillPane(cs.ui.id, p, app)
+		return nil
+	})
+	return &control.KillPaneResponse{}, err
+}
+
+func (cs *controlServer) ResizePane(ctx context.Context, req *control.ResizePaneRequest) (*control.ResizePaneResponse, error) {
+	err := cs.run(ctx, func(app gowid.IApp) error {
+		sess := cs.ui.session
+		p, err := sess.FindPane(cs.ui.id, req.Target)
+		if err != nil {
+			return err
+		}
+		return sess.WindowOf(p).ResizePane(p, int(req.Weight))
+	})
+	return &control.ResizePaneResponse{}, err
+}
+
+func (cs *controlServer) SelectLayout(ctx context.Context, req *control.SelectLayoutRequest) (*control.SelectLayoutResponse, error) {
+	err := cs.run(ctx, func(app gowid.IApp) error {
+		sess := cs.ui.session
+		p, err := sess.FindPane(cs.ui.id, req.Target)
+		if err != nil {
+			return err
+		}
+		return sess.WindowOf(p).SelectLayout(cs.ui.id, req.Layout, app)
+	})
+	return &control.SelectLayoutResponse{}, err
+}
+
+func (cs *controlServer) SwapPane(ctx context.Context, req *control.SwapPaneRequest) (*control.SwapPaneResponse, error) {
+	err := cs.run(ctx, func(app gowid.IApp) error {
+		sess := cs.ui.session
+		src, err := sess.FindPane(cs.ui.id, req.Source)
+		if err != nil {
+			return err
+		}
+		dst, err := sess.FindPane(cs.ui.id, req.Target)
+		if err != nil {
+			return err
+		}
+		return sess.SwapPanes(cs.ui.id, src, dst, app)
+	})
+	return &control.SwapPaneResponse{}, err
+}
+
+func (cs *controlServer) JoinPane(ctx context.Context, req *control.JoinPaneRequest) (*control.JoinPaneResponse, error) {
+	err := cs.run(ctx, func(app gowid.IApp) error {
+		sess := cs.ui.session
+		src, err := sess.FindPane(cs.ui.id, req.Source)
+		if err != nil {
+			return err
+		}
+		dst, err := sess.FindPane(cs.ui.id, req.Target)
+		if err != nil {
+			return err
+		}
+		return sess.JoinPane(cs.ui.id, src, dst, req.Vertical, app)
+	})
+	return &control.JoinPaneResponse{}, err
+}
+
+func (cs *controlServer) SetOption(ctx context.Context, req *control.SetOptionRequest) (*control.SetOptionResponse, error) {
+	err := cs.run(ctx, func(app gowid.IApp) error {
+		p, err := cs.ui.session.FindPane(cs.ui.id, req.Target)
+		if err != nil {
+			return err
+		}
+		return p.SetOption(req.Name, req.Value)
+	})
+	return &control.SetOptionResponse{}, err
+}
+
+func (cs *controlServer) Record(ctx context.Context, req *control.RecordRequest) (*control.RecordResponse, error) {
+	resp := &control.RecordResponse{}
+	err := cs.run(ctx, func(app gowid.IApp) error {
+		rec := cs.ui.screen.recorder
+		resp.Path = rec.path
+		if req.Stop {
+			if !rec.recording() {
+				return fmt.Errorf("not recording")
+			}
+			return rec.stop()
+		}
+
+		path := req.Path
+		if path == "" {
+			path = recordingPath()
+		}
+		resp.Path = path
+		return rec.start(cs.ui.screen.Screen, path, req.Panes)
+	})
+	return resp, err
+}
+
+func (cs *controlServer) PipePane(ctx context.Context, req *control.PipePaneRequest) (*control.PipePaneResponse, error) {
+	err := cs.run(ctx, func(app gowid.IApp) error {
+		p, err := cs.ui.session.FindPane(cs.ui.id, req.Target)
+		if err != nil {
+			return err
+		}
+
+		pipe := pane.Pipe{
+			Command: req.Command,
+			Path:    req.Path,
+			Strip:   req.Strip,
+		}
+		if req.Toggle && p.Pipe().Piping() {
+			pipe = pane.Pipe{}
+		}
+		return p.SetPipe(pipe)
+	})
+	return &control.PipePaneResponse{}, err
+}
+
+func (cs *controlServer) Events(req *control.EventsRequest, stream control.Control_EventsServer) error {
+	ch := make(chan *control.Event, 256)
+	sub, unsubscribe := cs.ui.screen.events.subscribe(ch)
+	defer unsubscribe()
+
+	return sub.stream(stream.Context(), ch, stream.Send)
+}
@@ -0,0 +1,243 @@
+package ui
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
+
+	"github.com/hinshun/ptmux/control"
+	"github.com/hinshun/ptmux/ui/widgets/mux"
+	"github.com/hinshun/ptmux/ui/widgets/pane"
+	"github.com/hinshun/ptmux/ui/widgets/session"
+)
+
+// events broadcasts changes to the session to control clients. Windows,
+// layouts and panes are compared against the last render to find what
+// changed, so that the widgets don't need to report every change themselves.
+// A nil *events, such as when playing back a recording, publishes nothing.
+type events struct {
+	session *session.Widget
+
+	mu   sync.RWMutex
+	subs map[chan *control.Event]*eventSub
+
+	// Only accessed from the main loop.
+	layouts map[*mux.Widget]string
+	outputs map[*pane.Widget]struct{}
+}
+
+func newEvents(sess *session.Widget) *events {
+	e := &events{
+		session: sess,
+		subs:    make(map[chan *control.Event]*eventSub),
+		layouts: make(map[*mux.Widget]string),
+		outputs: make(map[*pane.Widget]struct{}),
+	}
+	for _, win := range sess.Windows() {
+		e.layouts[win] = layoutKey(win)
+	}
+	return e
+}
+
+// eventSub counts the events dropped for a subscriber.
+type eventSub struct {
+	dropped uint64
+}
+
+// resync returns an event counting the events dropped since the last call,
+// or nil if none were. The events still queued in ch are dropped with them,
+// since they may be older than the ones that were missed and the client
+// captures the session again after a resync anyway.
+func (sub *eventSub) resync(ch chan *control.Event) *control.Event {
+	n := atomic.SwapUint64(&sub.dropped, 0)
+	if n == 0 {
+		return nil
+	}
+	for drained := false; !drained; {
+		select {
+		case <-ch:
+			n++
+		default:
+			drained = true
+		}
+	}
+	return &control.Event{
+		Event: &control.Event_Resync{
+			Resync: &control.ResyncEvent{Dropped: n},
+		},
+	}
+}
+
+// subscribe sends every event to ch until the returned function is called.
+// Events that don't fit in ch are counted by the returned eventSub.
+func (e *events) subscribe(ch chan *control.Event) (*eventSub, func()) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	sub := &eventSub{}
+	e.subs[ch] = sub
+	return sub, func() {
+		e.mu.Lock()
+		defer e.mu.Unlock()
+		delete(e.subs, ch)
+	}
+}
+
+// stream calls send with the events sent to ch until ctx is done, telling
+// the client when events were dropped.
+func (sub *eventSub) stream(ctx context.Context, ch chan *control.Event, send func(*control.Event) error) error {
+	for {
+		// Events are only dropped while ch is full, so one that is missed
+		// while waiting is noticed after the next event is sent.
+		if ev := sub.resync(ch); ev != nil {
+			err := send(ev)
+			if err != nil {
+				return err
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case ev := <-ch:
+			err := send(ev)
+			if err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// publish sends ev to every subscriber. Subscribers that have fallen behind
+// miss the event rather than blocking the main loop or the panes, and it is
+// counted so that they can be told.
+func (e *events) publish(ev *control.Event) {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+
+	for ch, sub := range e.subs {
+		select {
+		case ch <- ev:
+		default:
+			atomic.AddUint64(&sub.dropped, 1)
+		}
+	}
+}
+
+// update publishes the windows and layouts that changed since the last
+// render. It must be called from the main loop.
+func (e *events) update() {
+	if e == nil {
+		return
+	}
+	seen := make(map[*mux.Widget]bool)
+	panes := make(map[*pane.Widget]struct{})
+	for _, win := range e.session.Windows() {
+		seen[win] = true
+
+		key := layoutKey(win)
+		prev, ok := e.layouts[win]
+		if !ok {
+			e.publish(&control.Event{
+				Event: &control.Event_WindowAdd{
+					WindowAdd: &control.WindowAddEvent{Window: int32(win.ID())},
+				},
+			})
+		}
+		if !ok || key != prev {
+			e.layouts[win] = key
+			e.publish(layoutChange(win))
+		}
+
+		for _, p := range win.Panes() {
+			panes[p] = struct{}{}
+			if _, ok := e.outputs[p]; !ok && e.forward(p) {
+				e.outputs[p] = struct{}{}
+			}
+		}
+	}
+
+	for win := range e.layouts {
+		if seen[win] {
+			continue
+		}
+		delete(e.layouts, win)
+		e.publish(&control.Event{
+			Event: &control.Event_WindowClose{
+				WindowClose: &control.WindowCloseEvent{Window: int32(win.ID())},
+			},
+		})
+	}
+
+	for p := range e.outputs {
+		if _, ok := panes[p]; !ok {
+			delete(e.outputs, p)
+		}
+	}
+}
+
+// forward publishes the output of p, returning false if its process hasn't
+// started yet.
+func (e *events) forward(p *pane.Widget) bool {
+	ch := make(chan string, 16)
+	if !p.SubscribeOutput("control", ch) {
+		return false
+	}
+
+	go func() {
+		for data := range ch {
+			e.publish(&control.Event{
+				Event: &control.Event_Output{
+					Output: &control.OutputEvent{
+						Pane: int32(p.ID()),
+						Data: []byte(data),
+					},
+				},
+			})
+		}
+	}()
+	return true
+}
+
+func (e *events) peer(id string, joined bool) {
+	if e == nil {
+		return
+	}
+	if joined {
+		e.publish(&control.Event{
+			Event: &control.Event_PeerJoin{
+				PeerJoin: &control.PeerJoinEvent{Peer: id},
+			},
+		})
+		return
+	}
+	e.publish(&control.Event{
+		Event: &control.Event_PeerLeave{
+			PeerLeave: &control.PeerLeaveEvent{Peer: id},
+		},
+	})
+}
+
+// layoutKey returns the layout of win along with the order of its panes, so
+// that swapping or rotating them is seen as a change.
+func layoutKey(win *mux.Widget) string {
+	key := win.Layout()
+	for _, p := range win.Panes() {
+		key += fmt.Sprintf(" %d", p.ID())
+	}
+	return key
+}
+
+func layoutChange(win *mux.Widget) *control.Event {
+	ev := &control.LayoutChangeEvent{
+		Window: int32(win.ID()),
+		Layout: win.Layout(),
+	}
+	for _, p := range win.Panes() {
+		ev.Panes = append(ev.Panes, int32(p.ID()))
+	}
+	return &control.Event{
+		Event: &control.Event_LayoutChange{LayoutChange: ev},
+	}
+}
@@ -0,0 +1,193 @@
+package ui
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/gcla/gowid"
+	"github.com/hinshun/ptmux/control"
+	"github.com/hinshun/ptmux/pkg/state"
+	"github.com/hinshun/ptmux/ui/wid"
+	"github.com/hinshun/ptmux/ui/widgets/mux"
+	"github.com/hinshun/ptmux/ui/widgets/pane"
+	"github.com/hinshun/ptmux/ui/widgets/session"
+)
+
+func TestEventsResync(t *testing.T) {
+	for _, tc := range []struct {
+		name      string
+		buffer    int
+		published int
+		dropped   uint64
+	}{
+		{"keeping up", 4, 4, 0},
+		{"one behind", 4, 5, 1},
+		{"far behind", 1, 10, 9},
+		{"unbuffered", 0, 3, 3},
+	} {
+		e := &events{subs: make(map[chan *control.Event]*eventSub)}
+		ch := make(chan *control.Event, tc.buffer)
+		sub, unsubscribe := e.subscribe(ch)
+
+		for i := 0; i < tc.published; i++ {
+			e.publish(&control.Event{})
+		}
+		unsubscribe()
+		e.publish(&control.Event{})
+
+		if len(ch) != tc.published-int(tc.dropped) {
+			t.Errorf("%s: %d events queued, expected %d", tc.name, len(ch), tc.published-int(tc.dropped))
+		}
+		// The events still queued are dropped along with the missed ones.
+		dropped := uint64(tc.published)
+		ev := sub.resync(ch)
+		if tc.dropped == 0 {
+			if ev != nil {
+				t.Errorf("%s: unexpected resync %v", tc.name, ev)
+			}
+			continue
+		}
+		if ev == nil || ev.GetResync().GetDropped() != dropped {
+			t.Errorf("%s: expected resync of %d, got %v", tc.name, dropped, ev)
+		}
+		if len(ch) != 0 {
+			t.Errorf("%s: %d events still queued after resync", tc.name, len(ch))
+		}
+		if ev := sub.resync(ch); ev != nil {
+			t.Errorf("%s: resync not reset, got %v", tc.name, ev)
+		}
+	}
+}
+
+func TestEventsStream(t *testing.T) {
+	for _, tc := range []struct {
+		name   string
+		buffer int
+		before int
+		// during maps the number of events sent to the number published
+		// after sending them.
+		during   map[int]int
+		expected []string
+	}{
+		{"keeping up", 4, 2, nil, []string{"1", "2"}},
+		{"dropped before streaming", 2, 3, map[int]int{1: 1}, []string{"resync 3", "4"}},
+		{"dropped while sending", 2, 2, map[int]int{1: 2, 2: 1}, []string{"1", "resync 3", "5"}},
+	} {
+		e := &events{subs: make(map[chan *control.Event]*eventSub)}
+		ch := make(chan *control.Event, tc.buffer)
+		sub, unsubscribe := e.subscribe(ch)
+
+		published := 0
+		publish := func(n int) {
+			for i := 0; i < n; i++ {
+				published++
+				e.publish(&control.Event{
+					Event: &control.Event_Output{
+						Output: &control.OutputEvent{Pane: int32(published)},
+					},
+				})
+			}
+		}
+		publish(tc.before)
+
+		errDone := errors.New("done")
+		var sent []string
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		err := sub.stream(ctx, ch, func(ev *control.Event) error {
+			if resync := ev.GetResync(); resync != nil {
+				sent = append(sent, fmt.Sprintf("resync %d", resync.GetDropped()))
+			} else {
+				sent = append(sent, fmt.Sprint(ev.GetOutput().GetPane()))
+			}
+			if len(sent) == len(tc.expected) {
+				return errDone
+			}
+			publish(tc.during[len(sent)])
+			return nil
+		})
+		cancel()
+		unsubscribe()
+
+		if err != errDone {
+			t.Errorf("%s: unexpected error %v", tc.name, err)
+		}
+		if !reflect.DeepEqual(sent, tc.expected) {
+			t.Errorf("%s: sent %v, expected %v", tc.name, sent, tc.expected)
+		}
+	}
+}
+
+// testApp is an app with no peers, for rearranging panes outside the main
+// loop.
+type testApp struct {
+	gowid.IApp
+}
+
+var _ wid.IP2PApp = testApp{}
+
+func (testApp) IDs() []string                          { return nil }
+func (testApp) Viewer() string                         { return "" }
+func (testApp) Zoomed() bool                           { return false }
+func (testApp) PaneNumber(w gowid.IWidget) (int, bool) { return 0, false }
+func (testApp) FocusPalette(id string) (string, gowid.ICellStyler) {
+	return "", nil
+}
+
+func TestEventsLayoutChange(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		change   func(win *mux.Widget, panes []*pane.Widget)
+		expected []int
+	}{
+		{"unchanged", func(win *mux.Widget, panes []*pane.Widget) {}, nil},
+		{"swap", func(win *mux.Widget, panes []*pane.Widget) {
+			win.SwapPanes("", panes[0], panes[2], testApp{})
+		}, []int{2, 1, 0}},
+		{"rotate", func(win *mux.Widget, panes []*pane.Widget) {
+			win.RotatePanes("", testApp{})
+		}, []int{2, 0, 1}},
+	} {
+		sess, err := session.NewFromState("", &state.Session{
+			Windows: []state.Window{{
+				Layout: "{1:p,1:p,1:p}",
+				Panes:  []state.Pane{{}, {}, {}},
+			}},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		win := sess.Windows()[0]
+		panes := win.Panes()
+
+		e := newEvents(sess)
+		ch := make(chan *control.Event, 4)
+		_, unsubscribe := e.subscribe(ch)
+		tc.change(win, panes)
+		e.update()
+		unsubscribe()
+
+		var got []int32
+		if len(ch) > 0 {
+			ev := <-ch
+			if ev.GetLayoutChange() == nil {
+				t.Errorf("%s: unexpected event %v", tc.name, ev)
+				continue
+			}
+			got = ev.GetLayoutChange().GetPanes()
+		}
+		var expected []int32
+		for _, i := range tc.expected {
+			expected = append(expected, int32(panes[i].ID()))
+		}
+		if !reflect.DeepEqual(got, expected) {
+			t.Errorf("%s: layout change with panes %v, expected %v", tc.name, got, expected)
+		}
+		if len(ch) > 0 {
+			t.Errorf("%s: %d unexpected events", tc.name, len(ch))
+		}
+	}
+}
@@ -0,0 +1,334 @@
+package ui
+
+import (
+	"bytes"
+	"fmt"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gcla/gowid"
+	tcell "github.com/gdamore/tcell/v2"
+	"github.com/hinshun/ptmux/pkg/asciicast"
+	"github.com/hinshun/ptmux/ui/widgets/pane"
+	"github.com/hinshun/ptmux/ui/widgets/session"
+)
+
+// recorder records the session as asciicast, either the screen as the host
+// sees it in a single file, or the output of each pane in a file of its own.
+// Input typed by peers is recorded too, preceded by a marker naming the peer
+// whenever a different peer starts typing. It must only be used from the
+// main loop. A nil *recorder never records.
+type recorder struct {
+	session *session.Widget
+	path    string
+	panes   bool
+
+	screen *recording
+	frame  frame
+	files  map[*pane.Widget]*recording
+	inputs map[*pane.Widget]struct{}
+
+	// err is the first error starting the recording or the file of a
+	// pane, which is shown in the status line until the recording is
+	// stopped or started again.
+	err error
+}
+
+// recording is an asciicast file along with the last peer to type into it.
+type recording struct {
+	*asciicast.Writer
+	peer string
+}
+
+func (r *recording) input(peer string, data []byte) {
+	if peer != r.peer {
+		r.peer = peer
+		r.WriteEvent(asciicast.Marker, "peer "+peer)
+	}
+	r.WriteEvent(asciicast.Input, string(data))
+}
+
+func newRecorder(sess *session.Widget) *recorder {
+	return &recorder{session: sess}
+}
+
+func (r *recorder) recording() bool {
+	return r != nil && r.path != ""
+}
+
+// start starts recording to path. If panes is true, each pane is recorded to
+// path with its ID added before the extension, e.g. "pairing-3.cast".
+func (r *recorder) start(s tcell.Screen, path string, panes bool) error {
+	if r.recording() {
+		return fmt.Errorf("already recording to %s", r.path)
+	}
+
+	if !panes {
+		cols, rows := s.Size()
+		w, err := asciicast.Create(path, asciicast.Header{
+			Width:  cols,
+			Height: rows,
+			Title:  "ptmux",
+			Env:    map[string]string{"TERM": "xterm-256color"},
+		})
+		if err != nil {
+			return err
+		}
+		r.screen = &recording{Writer: w}
+		r.frame = frame{}
+	}
+
+	r.path = path
+	r.panes = panes
+	r.err = nil
+	r.files = make(map[*pane.Widget]*recording)
+	r.inputs = make(map[*pane.Widget]struct{})
+	return nil
+}
+
+// stop stops recording and closes every file.
+func (r *recorder) stop() error {
+	if !r.recording() {
+		return nil
+	}
+
+	var err error
+	for p := range r.inputs {
+		if term := p.GetTerminal(); term != nil {
+			term.RemoveOnInput(gowid.CallbackID{"record"})
+		}
+	}
+	for p, rec := range r.files {
+		if rec == nil {
+			continue
+		}
+		p.UnsubscribeOutput("record")
+		if cerr := rec.Close(); err == nil {
+			err = cerr
+		}
+	}
+	if r.screen != nil {
+		if cerr := r.screen.Close(); err == nil {
+			err = cerr
+		}
+	}
+
+	*r = recorder{session: r.session}
+	return err
+}
+
+// update records the screen if it is being recorded, and starts recording
+// panes that have started since the last render.
+func (r *recorder) update(s tcell.Screen) {
+	if !r.recording() {
+		return
+	}
+
+	if r.screen != nil {
+		r.drawScreen(s)
+	}
+
+	for _, win := range r.session.Windows() {
+		for _, p := range win.Panes() {
+			if r.panes {
+				if _, ok := r.files[p]; !ok {
+					r.recordPane(p)
+				}
+			}
+			if _, ok := r.inputs[p]; !ok {
+				r.recordInput(p)
+			}
+		}
+	}
+}
+
+func (r *recorder) recordPane(p *pane.Widget) {
+	cols, rows := p.Size()
+	if cols == 0 || rows == 0 {
+		// The process hasn't started yet.
+		return
+	}
+
+	ext := filepath.Ext(r.path)
+	path := strings.TrimSuffix(r.path, ext) + "-" + strconv.Itoa(p.ID()) + ext
+	w, err := asciicast.Create(path, asciicast.Header{
+		Width:  cols,
+		Height: rows,
+		Title:  p.Title(),
+		Env:    map[string]string{"TERM": "xterm-256color"},
+	})
+	if err != nil {
+		// Don't try to create the file again each time the screen is
+		// shown.
+		r.files[p] = nil
+		if r.err == nil {
+			r.err = err
+		}
+		return
+	}
+
+	ch := make(chan string, 16)
+	if !p.SubscribeOutput("record", ch) {
+		w.Close()
+		return
+	}
+	rec := &recording{Writer: w}
+	r.files[p] = rec
+
+	go func() {
+		for data := range ch {
+			rec.WriteOutput([]byte(data))
+		}
+	}()
+}
+
+func (r *recorder) recordInput(p *pane.Widget) {
+	term := p.GetTerminal()
+	if term == nil {
+		return
+	}
+	r.inputs[p] = struct{}{}
+
+	term.OnInput(gowid.WidgetCallbackExt{"record",
+		func(app gowid.IApp, _ gowid.IWidget, data ...interface{}) {
+			peer, seq := data[0].(string), data[1].([]byte)
+			if r.screen != nil {
+				r.screen.input(peer, seq)
+			} else if rec := r.files[p]; rec != nil {
+				rec.input(peer, seq)
+			}
+		},
+	})
+}
+
+// frame is the last screen written to the recording, so that only the cells
+// that changed are written each time the screen is shown.
+type frame struct {
+	cols, rows int
+	cells      []frameCell
+}
+
+type frameCell struct {
+	text  string
+	style tcell.Style
+	width int
+}
+
+func (r *recorder) drawScreen(s tcell.Screen) {
+	var buf bytes.Buffer
+	cols, rows := s.Size()
+	if cols != r.frame.cols || rows != r.frame.rows {
+		if r.frame.cells != nil {
+			r.screen.WriteResize(cols, rows)
+		}
+		r.frame = frame{
+			cols:  cols,
+			rows:  rows,
+			cells: make([]frameCell, cols*rows),
+		}
+		// Mark every cell as changed.
+		for i := range r.frame.cells {
+			r.frame.cells[i].width = -1
+		}
+		buf.WriteString("\x1b[?25l\x1b[0m\x1b[H\x1b[2J")
+	}
+
+	cx, cy := -1, -1
+	var (
+		cur      tcell.Style
+		curValid bool
+	)
+	for y := 0; y < rows; y++ {
+		for x := 0; x < cols; {
+			mainc, comb, style, width := s.GetContent(x, y)
+			if mainc == 0 {
+				mainc = ' '
+			}
+			if width < 1 {
+				width = 1
+			}
+
+			c := frameCell{
+				text:  string(append([]rune{mainc}, comb...)),
+				style: style,
+				width: width,
+			}
+			i := y*cols + x
+			if r.frame.cells[i] == c {
+				x += width
+				continue
+			}
+			r.frame.cells[i] = c
+
+			if cx != x || cy != y {
+				fmt.Fprintf(&buf, "\x1b[%d;%dH", y+1, x+1)
+			}
+			if !curValid || style != cur {
+				buf.WriteString(sgr(style))
+				cur, curValid = style, true
+			}
+			buf.WriteString(c.text)
+
+			x += width
+			cx, cy = x, y
+		}
+	}
+
+	if buf.Len() > 0 {
+		buf.WriteString("\x1b[0m")
+		r.screen.WriteOutput(buf.Bytes())
+	}
+}
+
+// sgr returns the escape sequence that selects style.
+func sgr(style tcell.Style) string {
+	fg, bg, attrs := style.Decompose()
+
+	params := []string{"0"}
+	for _, a := range []struct {
+		attr  tcell.AttrMask
+		param string
+	}{
+		{tcell.AttrBold, "1"},
+		{tcell.AttrDim, "2"},
+		{tcell.AttrItalic, "3"},
+		{tcell.AttrUnderline, "4"},
+		{tcell.AttrBlink, "5"},
+		{tcell.AttrReverse, "7"},
+		{tcell.AttrStrikeThrough, "9"},
+	} {
+		if attrs&a.attr != 0 {
+			params = append(params, a.param)
+		}
+	}
+	params = append(params, colorParams(fg, 30)...)
+	params = append(params, colorParams(bg, 40)...)
+	return "\x1b[" + strings.Join(params, ";") + "m"
+}
+
+func colorParams(c tcell.Color, base int) []string {
+	switch {
+	case c == tcell.ColorDefault || !c.Valid():
+		return nil
+	case c.IsRGB():
+		r, g, b := c.RGB()
+		return []string{strconv.Itoa(base + 8), "2", strconv.Itoa(int(r)), strconv.Itoa(int(g)), strconv.Itoa(int(b))}
+	}
+
+	n := int(c - tcell.ColorValid)
+	switch {
+	case n < 8:
+		return []string{strconv.Itoa(base + n)}
+	case n < 16:
+		return []string{strconv.Itoa(base + 60 + n - 8)}
+	}
+	return []string{strconv.Itoa(base + 8), "5", strconv.Itoa(n)}
+}
+
+// recordingPath returns the path a recording started without one is written
+// to.
+func recordingPath() string {
+	return fmt.Sprintf("ptmux-%s.cast", time.Now().Format("20060102-150405"))
+}
@@ -1,8 +1,16 @@
 package ui
 
 import (
+	"os"
+	"sync"
+
+	"github.com/gcla/gowid"
 	tcell "github.com/gdamore/tcell/v2"
+	"github.com/hinshun/ptmux/pkg/audit"
+	"github.com/hinshun/ptmux/pkg/hooks"
 	"github.com/hinshun/ptmux/pkg/pubsub"
+	"github.com/hinshun/ptmux/rvt"
+	"github.com/hinshun/ptmux/ui/wid"
 	"github.com/hinshun/ptmux/ui/widgets/peerstyled"
 )
 
@@ -10,13 +18,46 @@ const (
 	renderTopic = "render"
 )
 
+// renderOptions coalesce the renders of a peer that falls behind, since only
+// the latest screen matters, so that a slow peer never holds up the main loop.
+var renderOptions = pubsub.Options{Policy: pubsub.CoalesceLatest}
+
 type screen struct {
 	tcell.Screen
+	app       gowid.IApp
 	pubsub    *pubsub.Pubsub
 	peerstyle *peerstyled.Widget
+	events    *events
+	hooks     *hooks.Hooks
+	recorder  *recorder
+	audit     *audit.Log
+
+	// Peers whose view differs from the host's are rendered separately onto
+	// their own simulation screens.
+	viewsMu sync.Mutex
+	views   map[string]*rvt.RenderMessage
+	sims    map[string]tcell.SimulationScreen
+
+	// cursors holds where the cursor of the pane each peer has focused is
+	// on its screen.
+	cursors map[string]*rvt.Cursor
+
+	notifyMu sync.RWMutex
+	notify   map[string]chan *rvt.ShareMessage
+
+	// identities holds the identity each peer was authenticated as, which
+	// hooks are told rather than the ID the peer chose.
+	identitiesMu sync.Mutex
+	identities   map[string]string
+
+	// stats are the latest stats reported for each peer, and watchers the
+	// peers looking at them, for whom the screen is redrawn as they change.
+	statsMu  sync.Mutex
+	stats    map[string]rvt.PeerStats
+	watchers map[string]bool
 }
 
-func newScreen(peerstyle *peerstyled.Widget) (*screen, error) {
+func newScreen(peerstyle *peerstyled.Widget, events *events, rec *recorder, hks *hooks.Hooks) (*screen, error) {
 	s, err := tcell.NewScreen()
 	if err != nil {
 		return nil, err
@@ -31,20 +72,105 @@ func newScreen(peerstyle *peerstyled.Widget) (*screen, error) {
 
 	ps := pubsub.New()
 	return &screen{
-		Screen:    s,
-		pubsub:    ps,
-		peerstyle: peerstyle,
+		Screen:     s,
+		pubsub:     ps,
+		peerstyle:  peerstyle,
+		events:     events,
+		recorder:   rec,
+		hooks:      hks,
+		notify:     make(map[string]chan *rvt.ShareMessage),
+		identities: make(map[string]string),
+		views:      make(map[string]*rvt.RenderMessage),
+		sims:       make(map[string]tcell.SimulationScreen),
+		stats:      make(map[string]rvt.PeerStats),
+		watchers:   make(map[string]bool),
 	}, nil
 }
 
 func (s *screen) Show() {
+	s.renderViews()
+	s.events.update()
 	s.pubsub.Publish(renderTopic, "")
 	s.Screen.Show()
+	s.recorder.update(s.Screen)
 }
 
 func (s *screen) Sync() {
+	s.renderViews()
+	s.events.update()
 	s.pubsub.Publish(renderTopic, "")
 	s.Screen.Sync()
+	s.recorder.update(s.Screen)
+}
+
+// Render returns the screen as seen by the peer id.
+func (s *screen) Render(id string) *rvt.RenderMessage {
+	s.viewsMu.Lock()
+	msg, ok := s.views[id]
+	cursor := s.cursors[id]
+	s.viewsMu.Unlock()
+	if !ok {
+		msg = rvt.ScreenToRender(s.Screen)
+	}
+
+	if cursor != nil {
+		// Views are shared by every render until the next, so the cursor
+		// is set on a copy.
+		render := *msg
+		render.Cursor = cursor
+		return &render
+	}
+	return msg
+}
+
+// renderViews renders the widget hierarchy for every peer with a custom view.
+// It must be called from the gowid main loop.
+func (s *screen) renderViews() {
+	if s.app == nil {
+		return
+	}
+
+	cols, rows := s.Size()
+	views := make(map[string]*rvt.RenderMessage)
+	for _, id := range s.peerstyle.IDs() {
+		if !s.peerstyle.CustomView(id) {
+			continue
+		}
+
+		sim, ok := s.sims[id]
+		if !ok {
+			sim = tcell.NewSimulationScreen("UTF-8")
+			if err := sim.Init(); err != nil {
+				continue
+			}
+			s.sims[id] = sim
+		}
+		sim.SetSize(cols, rows)
+
+		canvas := s.peerstyle.Render(gowid.RenderBox{C: cols, R: rows}, gowid.Focused, wid.WithViewer(s.app, id))
+		gowid.Draw(canvas, s.app, sim)
+		views[id] = rvt.ScreenToRender(sim)
+	}
+
+	for id, sim := range s.sims {
+		if _, ok := views[id]; !ok {
+			sim.Fini()
+			delete(s.sims, id)
+		}
+	}
+
+	// The cursors are found once every view has been rendered.
+	cursors := make(map[string]*rvt.Cursor)
+	for _, id := range s.peerstyle.IDs() {
+		if x, y, lineEditing, ok := s.peerstyle.Cursor(id); ok {
+			cursors[id] = &rvt.Cursor{X: int32(x), Y: int32(y), Predict: lineEditing}
+		}
+	}
+
+	s.viewsMu.Lock()
+	s.views = views
+	s.cursors = cursors
+	s.viewsMu.Unlock()
 }
 
 func (s *screen) Clear() {
@@ -54,10 +180,208 @@ func (s *screen) Clear() {
 
 func (s *screen) Subscribe(id string, ch chan string) {
 	s.peerstyle.Add(id)
-	s.pubsub.Subscribe(renderTopic, id, ch)
+	s.pubsub.Subscribe(renderTopic, id, ch, renderOptions)
+	s.events.peer(id, true)
+	s.hooks.Run(hooks.PeerJoin, s.peerVars(id))
+}
+
+// Authenticate records the identity the peer id was authenticated as.
+func (s *screen) Authenticate(id, identity string) {
+	s.identitiesMu.Lock()
+	defer s.identitiesMu.Unlock()
+	s.identities[id] = identity
+}
+
+// peerVars returns the variables hooks are given about the peer id: its
+// authenticated identity, and the ID it chose for itself.
+func (s *screen) peerVars(id string) map[string]string {
+	s.identitiesMu.Lock()
+	defer s.identitiesMu.Unlock()
+
+	identity, ok := s.identities[id]
+	if !ok {
+		identity = id
+	}
+	return map[string]string{
+		"PTMUX_PEER":         identity,
+		"PTMUX_PEER_CLAIMED": id,
+	}
+}
+
+// Resubscribe sends renders to ch for a peer that reconnected, keeping the
+// color and focus it had. Peers that are no longer known join as new peers.
+func (s *screen) Resubscribe(id string, ch chan string) {
+	if !s.peerstyle.Has(id) {
+		s.Subscribe(id, ch)
+		return
+	}
+	s.pubsub.Subscribe(renderTopic, id, ch, renderOptions)
+}
+
+func (s *screen) SubscribeNotify(id string, ch chan *rvt.ShareMessage) {
+	s.notifyMu.Lock()
+	defer s.notifyMu.Unlock()
+
+	if prev, ok := s.notify[id]; ok {
+		close(prev)
+	}
+	s.notify[id] = ch
 }
 
+// Detach stops sending renders and notifications to a peer that
+// disconnected, keeping its color and focus until it resubscribes or is
+// unsubscribed.
+func (s *screen) Detach(id string) {
+	s.pubsub.Unsubscribe(renderTopic, id)
+	s.unsubscribeNotify(id)
+	s.forgetStats(id, false)
+}
+
+// Unsubscribe stops sending to the peer id and drops its color, focus and
+// views.
 func (s *screen) Unsubscribe(id string) {
-	s.peerstyle.Remove(id)
 	s.pubsub.Unsubscribe(renderTopic, id)
+	s.unsubscribeNotify(id)
+	s.forgetStats(id, true)
+
+	// The widget tree is only safe to change from the main loop while it is
+	// running.
+	remove := func(app gowid.IApp) {
+		s.peerstyle.Remove(id)
+		if app != nil {
+			app.Redraw()
+		}
+	}
+	if s.app == nil || s.app.Run(gowid.RunFunction(remove)) != nil {
+		remove(nil)
+	}
+
+	s.events.peer(id, false)
+	s.hooks.Run(hooks.PeerLeave, s.peerVars(id))
+
+	s.identitiesMu.Lock()
+	delete(s.identities, id)
+	s.identitiesMu.Unlock()
+}
+
+func (s *screen) unsubscribeNotify(id string) {
+	s.notifyMu.Lock()
+	defer s.notifyMu.Unlock()
+
+	if ch, ok := s.notify[id]; ok {
+		close(ch)
+		delete(s.notify, id)
+	}
+}
+
+// RenderStats returns how the renders published to each peer subscribed to
+// the screen were delivered.
+func (s *screen) RenderStats() map[string]pubsub.Stats {
+	return s.pubsub.Stats(renderTopic)
+}
+
+// ReportStats keeps the latest stats of the peer id, redrawing the screen if
+// any peer is looking at them.
+func (s *screen) ReportStats(id string, stats rvt.PeerStats) {
+	s.statsMu.Lock()
+	s.stats[id] = stats
+	watched := len(s.watchers) > 0
+	s.statsMu.Unlock()
+
+	if watched && s.app != nil {
+		s.app.Run(gowid.RunFunction(func(app gowid.IApp) {
+			app.Redraw()
+		}))
+	}
+}
+
+// PeerStats returns the latest stats of each connected peer, by ID.
+func (s *screen) PeerStats() map[string]rvt.PeerStats {
+	s.statsMu.Lock()
+	defer s.statsMu.Unlock()
+
+	stats := make(map[string]rvt.PeerStats, len(s.stats))
+	for id, st := range s.stats {
+		stats[id] = st
+	}
+	return stats
+}
+
+// WatchPeerStats sets whether the peer id is looking at the stats of peers.
+func (s *screen) WatchPeerStats(id string, watch bool) {
+	s.statsMu.Lock()
+	defer s.statsMu.Unlock()
+
+	if watch {
+		s.watchers[id] = true
+	} else {
+		delete(s.watchers, id)
+	}
+}
+
+// forgetStats drops the stats of a peer that disconnected, and whether it is
+// looking at them once it has left.
+func (s *screen) forgetStats(id string, left bool) {
+	s.statsMu.Lock()
+	defer s.statsMu.Unlock()
+
+	delete(s.stats, id)
+	if left {
+		delete(s.watchers, id)
+	}
+}
+
+// ToggleRecording starts recording the screen to a new file in the current
+// directory, or stops the recording in progress.
+func (s *screen) ToggleRecording(app gowid.IApp) {
+	if s.recorder == nil {
+		return
+	}
+	if s.recorder.recording() {
+		s.recorder.stop()
+		return
+	}
+	err := s.recorder.start(s.Screen, recordingPath(), false)
+	if err != nil {
+		s.recorder.err = err
+	}
+}
+
+// Recording returns true if the session is being recorded.
+func (s *screen) Recording() bool {
+	return s.recorder.recording()
+}
+
+// RecordingError returns the error that stopped the session or a pane from
+// being recorded, if any.
+func (s *screen) RecordingError() error {
+	if s.recorder == nil {
+		return nil
+	}
+	return s.recorder.err
+}
+
+// RunHook runs the hooks configured for ev.
+func (s *screen) RunHook(ev hooks.Event, vars map[string]string) {
+	s.hooks.Run(ev, vars)
+}
+
+// SetClipboard sets the clipboard of the host's terminal.
+func (s *screen) SetClipboard(data []byte) {
+	rvt.WriteClipboard(os.Stdout, data)
+}
+
+// Notify sends msg to every subscriber. It is called from the gowid main loop,
+// so subscribers that have fallen behind miss the message rather than
+// blocking rendering.
+func (s *screen) Notify(msg *rvt.ShareMessage) {
+	s.notifyMu.RLock()
+	defer s.notifyMu.RUnlock()
+
+	for _, ch := range s.notify {
+		select {
+		case ch <- msg:
+		default:
+		}
+	}
 }
@@ -1,54 +1,177 @@
 package ui
 
 import (
+	"context"
 	"io/ioutil"
 
 	"github.com/gcla/gowid"
 	tcell "github.com/gdamore/tcell/v2"
+	"github.com/hinshun/ptmux/pkg/asciicast"
+	"github.com/hinshun/ptmux/pkg/audit"
+	"github.com/hinshun/ptmux/pkg/hooks"
+	"github.com/hinshun/ptmux/pkg/state"
 	"github.com/hinshun/ptmux/rvt"
-	"github.com/hinshun/ptmux/ui/widgets/mux"
 	"github.com/hinshun/ptmux/ui/widgets/peerstyled"
+	"github.com/hinshun/ptmux/ui/widgets/player"
+	"github.com/hinshun/ptmux/ui/widgets/session"
 	"github.com/sirupsen/logrus"
 )
 
 type UI struct {
-	app    *gowid.App
-	screen *screen
+	id      string
+	app     *gowid.App
+	screen  *screen
+	session *session.Widget
+	hooks   *hooks.Hooks
+	player  *player.Widget
 }
 
-func New(id string) (*UI, error) {
-	peerstyle := peerstyled.New(id, mux.New(id))
+// New returns a UI for the host id. If st is not nil, the windows and panes of
+// the saved session are restored. Hooks are run as things happen in the
+// session, and may be nil.
+func New(id string, st *state.Session, hks *hooks.Hooks) (*UI, error) {
+	sess := session.New(id)
+	if st != nil {
+		var err error
+		sess, err = session.NewFromState(id, st)
+		if err != nil {
+			return nil, err
+		}
+	}
+	peerstyle := peerstyled.New(id, sess)
+
+	s, err := newScreen(peerstyle, newEvents(sess), newRecorder(sess), hks)
+	if err != nil {
+		return nil, err
+	}
+
+	app, err := newApp(s, peerstyle)
+	if err != nil {
+		s.Fini()
+		return nil, err
+	}
+
+	return &UI{
+		id:      id,
+		app:     app,
+		screen:  s,
+		session: sess,
+		hooks:   hks,
+	}, nil
+}
+
+// NewPlayer returns a UI for the host id that plays back rec. Peers that
+// attach to it watch the playback as spectators.
+func NewPlayer(id string, rec *asciicast.Recording) (*UI, error) {
+	pl, err := player.New(id, rec)
+	if err != nil {
+		return nil, err
+	}
+	peerstyle := peerstyled.New(id, pl)
 
-	s, err := newScreen(peerstyle)
+	s, err := newScreen(peerstyle, nil, nil, nil)
 	if err != nil {
 		return nil, err
 	}
 
+	app, err := newApp(s, peerstyle)
+	if err != nil {
+		s.Fini()
+		return nil, err
+	}
+
+	return &UI{
+		id:     id,
+		app:    app,
+		screen: s,
+		player: pl,
+	}, nil
+}
+
+func newApp(s *screen, view gowid.IWidget) (*gowid.App, error) {
 	log := logrus.New()
 	log.SetOutput(ioutil.Discard)
 
 	app, err := gowid.NewApp(gowid.AppArgs{
 		Screen: s,
-		View:   peerstyle,
+		View:   view,
 		Log:    log,
 		// EnableMouseMotion: true,
 	})
 	if err != nil {
 		return nil, err
 	}
+	s.app = app
+	return app, nil
+}
 
-	return &UI{
-		app:    app,
-		screen: s,
-	}, nil
+// State returns the state needed to restore the session, or nil if every pane
+// has exited and there is nothing left to restore.
+func (ui *UI) State(ctx context.Context) (*state.Session, error) {
+	stCh := make(chan *state.Session, 1)
+	snapshot := func(app gowid.IApp) {
+		if ui.session.Exited() {
+			stCh <- nil
+			return
+		}
+		st := ui.session.State()
+		st.Hooks = ui.hooks.Commands()
+		stCh <- st
+	}
+
+	// The widget tree is only safe to walk from the main loop while it is
+	// running. Once it has stopped, the state is taken directly.
+	err := ui.app.Run(gowid.RunFunction(snapshot))
+	if err != nil {
+		snapshot(ui.app)
+		return <-stCh, nil
+	}
+
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case st := <-stCh:
+		return st, nil
+	}
+}
+
+// StartRecording records the session to the asciicast file at path, or to a
+// file per pane if panes is true. It must be called before Loop.
+func (ui *UI) StartRecording(path string, panes bool) error {
+	return ui.screen.recorder.start(ui.screen.Screen, path, panes)
+}
+
+// SetAuditLog logs the input of remote peers to l. It must be called before
+// Loop.
+func (ui *UI) SetAuditLog(l *audit.Log) {
+	ui.screen.audit = l
+}
+
+// Close restores the terminal of a UI that won't be run, such as when setting
+// up the session failed after the UI was created.
+func (ui *UI) Close() {
+	ui.app.Close()
 }
 
 func (ui *UI) Screen() rvt.Screen {
 	return ui.screen
 }
 
+// Loop runs the UI until it quits, running the session-start and
+// session-stop hooks around it. A UI returned by NewPlayer plays its
+// recording while it runs.
 func (ui *UI) Loop() {
+	if ui.player != nil {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		go ui.player.Play(ctx, ui.app)
+	}
+
+	ui.hooks.Run(hooks.SessionStart, nil)
 	ui.app.MainLoop(gowid.UnhandledInputFunc(HandleQuitKeys))
+	ui.screen.recorder.stop()
+	ui.hooks.Run(hooks.SessionStop, nil)
+	ui.hooks.Wait()
 }
 
 func HandleQuitKeys(app gowid.IApp, event interface{}) bool {
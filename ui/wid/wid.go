@@ -1,6 +1,7 @@
 package wid
 
 import (
+	"fmt"
 	"sort"
 
 	"github.com/gcla/gowid"
@@ -13,13 +14,60 @@ type IFocus interface {
 	ReverseFocus() [][]string
 }
 
+// IForget is implemented by widgets that keep state for each peer, such as
+// its focus, so that the state of a peer that left can be dropped. Widgets
+// forget the peer in their sub-widgets too.
+type IForget interface {
+	Forget(id string)
+}
+
+// Forget drops the state kept for the peer id by w and the widgets below it.
+func Forget(w gowid.IWidget, id string) {
+	switch cw := w.(type) {
+	case IForget:
+		cw.Forget(id)
+	case gowid.ICompositeMultiple:
+		for _, sub := range cw.SubWidgets() {
+			Forget(sub, id)
+		}
+	case gowid.IComposite:
+		Forget(cw.SubWidget(), id)
+	}
+}
+
 type ICompositeMultipleFocus interface {
 	gowid.ICompositeMultiple
 	IFocus
 }
 
+// IViews is implemented by widgets that render differently depending on which
+// peer is viewing them.
+type IViews interface {
+	// CustomView returns true if the peer's view differs from the host's view.
+	CustomView(id string) bool
+}
+
+// ICursors is implemented by widgets that know which pane each peer has
+// focused, so that the cursor of the pane can be found on the canvas the peer
+// sees.
+type ICursors interface {
+	// Cursor returns the name of the canvas mark at the cursor of the pane
+	// the peer id has focused, and whether the pane appears to be editing a
+	// line. It returns false if the peer has no focused pane.
+	Cursor(id string) (mark string, lineEditing bool, ok bool)
+}
+
+// CursorMark returns the name of the canvas mark at the cursor of the pane
+// with the stable ID pane.
+func CursorMark(pane int) string {
+	return fmt.Sprintf("cursor%%%d", pane)
+}
+
 type IP2PApp interface {
 	IDs() []string
+	Viewer() string
+	Zoomed() bool
+	PaneNumber(w gowid.IWidget) (int, bool)
 	FocusPalette(id string) (string, gowid.ICellStyler)
 	SetClickTarget(k tcell.ButtonMask, w gowid.IIdentityWidget) bool
 	ClickTarget(func(tcell.ButtonMask, gowid.IIdentityWidget))
@@ -29,6 +77,9 @@ type IP2PApp interface {
 
 type app struct {
 	gowid.IApp
+	viewer         string
+	zoomed         bool
+	numbers        map[gowid.IWidget]int
 	ids            []string
 	palette        map[string]gowid.ICellStyler
 	clickTargets   gowid.ClickTargets
@@ -42,8 +93,19 @@ func WithP2PContext(a gowid.IApp, palette map[string]gowid.ICellStyler, clickTar
 		ids = append(ids, id)
 	}
 	sort.Strings(ids)
+	var (
+		viewer  string
+		zoomed  bool
+		numbers map[gowid.IWidget]int
+	)
+	if pa, ok := a.(*app); ok {
+		viewer, zoomed, numbers = pa.viewer, pa.zoomed, pa.numbers
+	}
 	return &app{
 		IApp:           a,
+		viewer:         viewer,
+		zoomed:         zoomed,
+		numbers:        numbers,
 		ids:            ids,
 		palette:        palette,
 		clickTargets:   clickTargets,
@@ -59,6 +121,9 @@ func WithFocus(a gowid.IApp, ids []string) gowid.IApp {
 	}
 	return &app{
 		IApp:           a,
+		viewer:         fa.viewer,
+		zoomed:         fa.zoomed,
+		numbers:        fa.numbers,
 		ids:            ids,
 		palette:        fa.palette,
 		clickTargets:   fa.clickTargets,
@@ -67,6 +132,77 @@ func WithFocus(a gowid.IApp, ids []string) gowid.IApp {
 	}
 }
 
+// WithViewer returns an app that renders the widget hierarchy as seen by the
+// peer id.
+func WithViewer(a gowid.IApp, id string) gowid.IApp {
+	pa := &app{IApp: a}
+	if fa, ok := a.(*app); ok {
+		*pa = *fa
+	}
+	pa.viewer = id
+	return pa
+}
+
+// WithZoom returns an app that renders a zoomed pane.
+func WithZoom(a gowid.IApp) gowid.IApp {
+	pa := &app{IApp: a}
+	if fa, ok := a.(*app); ok {
+		*pa = *fa
+	}
+	pa.zoomed = true
+	return pa
+}
+
+// WithPaneNumbers returns an app that renders each pane in numbers with its
+// number drawn over it.
+func WithPaneNumbers(a gowid.IApp, numbers map[gowid.IWidget]int) gowid.IApp {
+	pa := &app{IApp: a}
+	if fa, ok := a.(*app); ok {
+		*pa = *fa
+	}
+	pa.numbers = numbers
+	return pa
+}
+
+// Viewer returns the id of the peer the app is rendering for, or defaultID if
+// it is rendering the host's screen.
+func Viewer(a gowid.IApp, defaultID string) string {
+	if pa, ok := a.(IP2PApp); ok && pa.Viewer() != "" {
+		return pa.Viewer()
+	}
+	return defaultID
+}
+
+// Zoomed returns true if the app is rendering a zoomed pane.
+func Zoomed(a gowid.IApp) bool {
+	if pa, ok := a.(IP2PApp); ok {
+		return pa.Zoomed()
+	}
+	return false
+}
+
+// PaneNumber returns the number to draw over the pane w, if the app is
+// rendering pane numbers.
+func PaneNumber(a gowid.IApp, w gowid.IWidget) (int, bool) {
+	if pa, ok := a.(IP2PApp); ok {
+		return pa.PaneNumber(w)
+	}
+	return 0, false
+}
+
+func (a *app) Viewer() string {
+	return a.viewer
+}
+
+func (a *app) Zoomed() bool {
+	return a.zoomed
+}
+
+func (a *app) PaneNumber(w gowid.IWidget) (int, bool) {
+	n, ok := a.numbers[w]
+	return n, ok
+}
+
 func (a *app) IDs() []string {
 	sort.Strings(a.ids)
 	return a.ids
@@ -66,6 +66,14 @@ func (w *Widget) Focus(id string) int {
 	return focus
 }
 
+// Forget drops the focus of the peer id.
+func (w *Widget) Forget(id string) {
+	delete(w.focus, id)
+	for _, sub := range w.widgets {
+		wid.Forget(sub, id)
+	}
+}
+
 func (w *Widget) ReverseFocus() [][]string {
 	rfocus := make([][]string, len(w.widgets))
 	for id, focus := range w.focus {
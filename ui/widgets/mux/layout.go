@@ -0,0 +1,526 @@
+package mux
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/gcla/gowid"
+	"github.com/hinshun/ptmux/pkg/state"
+	"github.com/hinshun/ptmux/ui/wid"
+	"github.com/hinshun/ptmux/ui/widgets/columns"
+	"github.com/hinshun/ptmux/ui/widgets/pane"
+	"github.com/hinshun/ptmux/ui/widgets/pile"
+)
+
+var mainWeight = 2
+
+type layoutKind int
+
+const (
+	layoutPane layoutKind = iota
+	layoutColumns
+	layoutPile
+)
+
+// layout describes the shape of a columns/pile tree independently of the
+// panes in it.
+//
+// Its string form nests columns in braces and piles in brackets, with each
+// child prefixed by its weight, and each pane written as "p". For example,
+// "{2:p,1:[1:p,1:p]}" is a pane on the left taking two thirds of the width,
+// and two panes stacked on the right.
+type layout struct {
+	kind     layoutKind
+	weight   int
+	children []*layout
+}
+
+func (l *layout) String() string {
+	if l.kind == layoutPane {
+		return "p"
+	}
+
+	open, close := "{", "}"
+	if l.kind == layoutPile {
+		open, close = "[", "]"
+	}
+
+	children := make([]string, len(l.children))
+	for i, child := range l.children {
+		children[i] = fmt.Sprintf("%d:%s", child.weight, child)
+	}
+	return open + strings.Join(children, ",") + close
+}
+
+func (l *layout) numPanes() int {
+	if l.kind == layoutPane {
+		return 1
+	}
+	n := 0
+	for _, child := range l.children {
+		n += child.numPanes()
+	}
+	return n
+}
+
+func paneLayout(weight int) *layout {
+	return &layout{kind: layoutPane, weight: weight}
+}
+
+func splitLayout(kind layoutKind, weight int, children []*layout) *layout {
+	if len(children) == 1 {
+		children[0].weight = weight
+		return children[0]
+	}
+	return &layout{kind: kind, weight: weight, children: children}
+}
+
+func evenLayout(kind layoutKind, n int) *layout {
+	children := make([]*layout, n)
+	for i := range children {
+		children[i] = paneLayout(1)
+	}
+	return splitLayout(kind, 1, children)
+}
+
+// namedLayout returns the named layout for n panes.
+func namedLayout(name string, n int) (*layout, error) {
+	if n < 1 {
+		return nil, fmt.Errorf("no panes to lay out")
+	}
+
+	switch name {
+	case state.LayoutEvenHorizontal:
+		return evenLayout(layoutColumns, n), nil
+	case state.LayoutEvenVertical:
+		return evenLayout(layoutPile, n), nil
+	case state.LayoutMainHorizontal, state.LayoutMainVertical:
+		if n == 1 {
+			return paneLayout(1), nil
+		}
+		outer, inner := layoutPile, layoutColumns
+		if name == state.LayoutMainVertical {
+			outer, inner = layoutColumns, layoutPile
+		}
+		rest := evenLayout(inner, n-1)
+		rest.weight = 1
+		return splitLayout(outer, 1, []*layout{paneLayout(mainWeight), rest}), nil
+	case state.LayoutTiled:
+		cols := int(math.Ceil(math.Sqrt(float64(n))))
+		var rows []*layout
+		for i := 0; i < n; i += cols {
+			m := cols
+			if n-i < m {
+				m = n - i
+			}
+			rows = append(rows, evenLayout(layoutColumns, m))
+		}
+		return splitLayout(layoutPile, 1, rows), nil
+	default:
+		return nil, fmt.Errorf("unknown layout %q", name)
+	}
+}
+
+// parseLayout parses either a named layout or the string form of a layout.
+func parseLayout(s string, n int) (*layout, error) {
+	for _, name := range state.Layouts {
+		if s == name {
+			return namedLayout(name, n)
+		}
+	}
+
+	// Layout strings start with a pane, columns or a pile, so anything else
+	// is a layout name.
+	if s == "" || !strings.ContainsAny(s[:1], "p{[") {
+		return nil, fmt.Errorf("unknown layout %q", s)
+	}
+
+	lp := &layoutParser{s: s}
+	l, err := lp.parse()
+	if err != nil {
+		return nil, err
+	}
+	l.weight = 1
+
+	if l.numPanes() != n {
+		return nil, fmt.Errorf("layout %q has %d panes but there are %d", s, l.numPanes(), n)
+	}
+	return l, nil
+}
+
+type layoutParser struct {
+	s   string
+	pos int
+}
+
+func (lp *layoutParser) parse() (*layout, error) {
+	l, err := lp.node()
+	if err != nil {
+		return nil, err
+	}
+	if lp.pos != len(lp.s) {
+		return nil, lp.errorf("unexpected %q", lp.s[lp.pos:])
+	}
+	return l, nil
+}
+
+func (lp *layoutParser) errorf(format string, a ...interface{}) error {
+	return fmt.Errorf("invalid layout at offset %d: %s", lp.pos, fmt.Sprintf(format, a...))
+}
+
+func (lp *layoutParser) node() (*layout, error) {
+	if lp.pos >= len(lp.s) {
+		return nil, lp.errorf("unexpected end of layout")
+	}
+
+	var (
+		kind  layoutKind
+		close byte
+	)
+	switch lp.s[lp.pos] {
+	case 'p':
+		lp.pos++
+		return paneLayout(1), nil
+	case '{':
+		kind, close = layoutColumns, '}'
+	case '[':
+		kind, close = layoutPile, ']'
+	default:
+		return nil, lp.errorf("expected 'p', '{' or '['")
+	}
+	lp.pos++
+
+	l := &layout{kind: kind}
+	for {
+		weight, err := lp.weight()
+		if err != nil {
+			return nil, err
+		}
+
+		child, err := lp.node()
+		if err != nil {
+			return nil, err
+		}
+		child.weight = weight
+		l.children = append(l.children, child)
+
+		if lp.pos >= len(lp.s) {
+			return nil, lp.errorf("unexpected end of layout")
+		}
+		switch lp.s[lp.pos] {
+		case ',':
+			lp.pos++
+			continue
+		case close:
+			lp.pos++
+		default:
+			return nil, lp.errorf("expected ',' or %q", close)
+		}
+		break
+	}
+
+	if len(l.children) < 2 {
+		return nil, lp.errorf("a split needs at least two children")
+	}
+	return l, nil
+}
+
+func (lp *layoutParser) weight() (int, error) {
+	end := strings.IndexByte(lp.s[lp.pos:], ':')
+	if end < 0 {
+		return 0, lp.errorf("expected weight")
+	}
+
+	weight, err := strconv.Atoi(lp.s[lp.pos : lp.pos+end])
+	if err != nil || weight < 1 {
+		return 0, lp.errorf("invalid weight %q", lp.s[lp.pos:lp.pos+end])
+	}
+	lp.pos += end + 1
+	return weight, nil
+}
+
+// NewWithLayout returns a mux with panes arranged by a named layout or
+// layout string.
+func NewWithLayout(defaultID, s string, panes []*pane.Widget) (*Widget, error) {
+	l, err := parseLayout(s, len(panes))
+	if err != nil {
+		return nil, err
+	}
+
+	w := NewWithPane(defaultID, nil)
+	for _, p := range panes {
+		w.adopt(p)
+	}
+	w.IWidget, _ = w.buildLayout(l, panes)
+	return w, nil
+}
+
+// State returns the layout of the mux and the state of each of its panes.
+func (w *Widget) State() state.Window {
+	st := state.Window{
+		Layout: w.Layout(),
+		Active: w.PaneIndex(findFocusedPane(w.defaultID, w.IWidget)),
+	}
+	if st.Active < 0 {
+		st.Active = 0
+	}
+	for _, p := range w.Panes() {
+		st.Panes = append(st.Panes, p.State())
+	}
+	return st
+}
+
+// Layout returns the string form of the current layout, which can be passed
+// back to SelectLayout to reproduce it exactly.
+func (w *Widget) Layout() string {
+	l := layoutOf(w.IWidget)
+	if l == nil {
+		return ""
+	}
+	return l.String()
+}
+
+func layoutOf(w gowid.IWidget) *layout {
+	if _, ok := w.(*pane.Widget); ok {
+		return paneLayout(1)
+	}
+	if cw, ok := w.(gowid.IComposite); ok {
+		w = cw.SubWidget()
+	}
+
+	var kind layoutKind
+	switch w.(type) {
+	case *columns.Widget:
+		kind = layoutColumns
+	case *pile.Widget:
+		kind = layoutPile
+	default:
+		return nil
+	}
+
+	cmd := w.(gowid.ICompositeMultipleDimensions)
+	dims := cmd.Dimensions()
+	l := &layout{kind: kind}
+	for i, sub := range cmd.SubWidgets() {
+		child := layoutOf(sub)
+		if child == nil {
+			continue
+		}
+		child.weight = 1
+		if rw, ok := dims[i].(gowid.IRenderWithWeight); ok {
+			child.weight = rw.Weight()
+		}
+		l.children = append(l.children, child)
+	}
+	return l
+}
+
+// Panes returns every pane in the mux, in depth-first order.
+func (w *Widget) Panes() []*pane.Widget {
+	return findPanes(w.IWidget)
+}
+
+func findPanes(w gowid.IWidget) []*pane.Widget {
+	if p, ok := w.(*pane.Widget); ok {
+		return []*pane.Widget{p}
+	}
+	if cw, ok := w.(gowid.IComposite); ok {
+		w = cw.SubWidget()
+	}
+
+	var panes []*pane.Widget
+	if cw, ok := w.(gowid.ICompositeMultiple); ok {
+		for _, sub := range cw.SubWidgets() {
+			panes = append(panes, findPanes(sub)...)
+		}
+	}
+	return panes
+}
+
+// NextLayout cycles to the next named layout.
+func (w *Widget) NextLayout(id string, app gowid.IApp) {
+	w.layoutIdx = (w.layoutIdx + 1) % len(state.Layouts)
+	w.SelectLayout(id, state.Layouts[w.layoutIdx], app)
+}
+
+// SelectLayout rebuilds the columns/pile tree for the current panes using
+// either a named layout or the string form returned by Layout.
+func (w *Widget) SelectLayout(id string, s string, app gowid.IApp) error {
+	panes := w.Panes()
+	l, err := parseLayout(s, len(panes))
+	if err != nil {
+		return err
+	}
+
+	for i, name := range state.Layouts {
+		if s == name {
+			w.layoutIdx = i
+		}
+	}
+
+	w.rebuild(l, panes, app)
+	return nil
+}
+
+// rebuild replaces the tree with one shaped like l, keeping every peer's
+// focus on the pane it was focused on before.
+func (w *Widget) rebuild(l *layout, panes []*pane.Widget, app gowid.IApp) {
+	ids := app.(wid.IP2PApp).IDs()
+	focused := make(map[string]*pane.Widget)
+	for _, id := range ids {
+		focused[id] = findFocusedPane(id, w.IWidget)
+	}
+
+	root, _ := w.buildLayout(l, panes)
+	w.SetSubWidget(root, app)
+
+	for _, id := range ids {
+		if p := focused[id]; p != nil {
+			w.FocusPane(id, p)
+		}
+	}
+}
+
+func (w *Widget) buildLayout(l *layout, panes []*pane.Widget) (gowid.IContainerWidget, []*pane.Widget) {
+	if l.kind == layoutPane {
+		p := panes[0]
+		p.SetDimension(gowid.RenderWithWeight{l.weight})
+		return p, panes[1:]
+	}
+
+	containers := make([]gowid.IContainerWidget, len(l.children))
+	for i, child := range l.children {
+		containers[i], panes = w.buildLayout(child, panes)
+	}
+
+	var list gowid.IWidget
+	if l.kind == layoutColumns {
+		list = columns.New(w.defaultID, containers)
+	} else {
+		list = pile.New(w.defaultID, containers)
+	}
+	return &gowid.ContainerWidget{
+		IWidget: list,
+		D:       gowid.RenderWithWeight{l.weight},
+	}, panes
+}
+
+// FocusPane moves the focus of the peer id onto p by focusing every
+// columns/pile on the path from the root to p.
+func (w *Widget) FocusPane(id string, p *pane.Widget) bool {
+	return focusPath(id, w.IWidget, p)
+}
+
+func focusPath(id string, w gowid.IWidget, p *pane.Widget) bool {
+	if wp, ok := w.(*pane.Widget); ok {
+		return wp == p
+	}
+	if cw, ok := w.(gowid.IComposite); ok {
+		w = cw.SubWidget()
+	}
+
+	cmf, ok := w.(wid.ICompositeMultipleFocus)
+	if !ok {
+		return false
+	}
+	for i, sub := range cmf.SubWidgets() {
+		if focusPath(id, sub, p) {
+			cmf.SetFocus(id, i)
+			return true
+		}
+	}
+	return false
+}
+
+// PaneIndex returns the index of p in depth-first order, or -1 if p is not in
+// the mux.
+func (w *Widget) PaneIndex(p *pane.Widget) int {
+	for i, wp := range w.Panes() {
+		if wp == p {
+			return i
+		}
+	}
+	return -1
+}
+
+// SiblingPane returns the pane offset from p in depth-first order, wrapping
+// around at either end.
+func (w *Widget) SiblingPane(p *pane.Widget, offset int) *pane.Widget {
+	panes := w.Panes()
+	i := w.PaneIndex(p)
+	if i < 0 {
+		return nil
+	}
+	n := len(panes)
+	return panes[((i+offset)%n+n)%n]
+}
+
+// SwapPanes exchanges the positions of p and q. Every peer's focus follows the
+// pane it was focused on.
+func (w *Widget) SwapPanes(id string, p, q *pane.Widget, app gowid.IApp) {
+	i, j := w.PaneIndex(p), w.PaneIndex(q)
+	if i < 0 || j < 0 || i == j {
+		return
+	}
+
+	panes := w.Panes()
+	panes[i], panes[j] = panes[j], panes[i]
+	w.rebuild(layoutOf(w.IWidget), panes, app)
+}
+
+// ReplacePane puts q, which must not be in the mux, in the place of p, which
+// is left in no mux. Peers focused on p are focused on q.
+func (w *Widget) ReplacePane(p, q *pane.Widget, app gowid.IApp) {
+	i := w.PaneIndex(p)
+	if i < 0 || q == nil {
+		return
+	}
+
+	var ids []string
+	for _, id := range app.(wid.IP2PApp).IDs() {
+		if findFocusedPane(id, w.IWidget) == p {
+			ids = append(ids, id)
+		}
+	}
+	for zid, zp := range w.zoomed {
+		if zp == p {
+			delete(w.zoomed, zid)
+		}
+	}
+	p.SetZoomed(false)
+
+	panes := w.Panes()
+	panes[i] = q
+	w.adopt(q)
+	w.rebuild(layoutOf(w.IWidget), panes, app)
+	for _, id := range ids {
+		w.FocusPane(id, q)
+	}
+}
+
+// RotatePanes moves every pane forward one position in depth-first order,
+// with the last pane moving to the first position.
+func (w *Widget) RotatePanes(id string, app gowid.IApp) {
+	panes := w.Panes()
+	if len(panes) < 2 {
+		return
+	}
+
+	rotated := append([]*pane.Widget{panes[len(panes)-1]}, panes[:len(panes)-1]...)
+	w.rebuild(layoutOf(w.IWidget), rotated, app)
+}
+
+// ResizePane sets the weight of p relative to the other children of its
+// columns or pile.
+func (w *Widget) ResizePane(p *pane.Widget, weight int) error {
+	if weight < 1 {
+		return fmt.Errorf("invalid weight %d", weight)
+	}
+	if !w.Contains(p) {
+		return fmt.Errorf("pane is not in the window")
+	}
+	p.SetDimension(gowid.RenderWithWeight{weight})
+	return nil
+}
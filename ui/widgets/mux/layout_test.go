@@ -0,0 +1,95 @@
+package mux
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hinshun/ptmux/pkg/state"
+)
+
+func TestLayoutRoundTrip(t *testing.T) {
+	for _, tc := range []struct {
+		layout string
+		panes  int
+	}{
+		{"p", 1},
+		{"{1:p,1:p}", 2},
+		{"[1:p,1:p,1:p]", 3},
+		{"{2:p,1:[1:p,1:p]}", 3},
+		{"[3:{1:p,2:p},1:p]", 3},
+		{"{1:[1:p,1:{1:p,1:p}],1:p,4:[1:p,1:p]}", 6},
+	} {
+		l, err := parseLayout(tc.layout, tc.panes)
+		if err != nil {
+			t.Errorf("%s: %s", tc.layout, err)
+			continue
+		}
+		if got := l.String(); got != tc.layout {
+			t.Errorf("%s: serialized as %s", tc.layout, got)
+		}
+	}
+}
+
+func TestNamedLayouts(t *testing.T) {
+	for _, tc := range []struct {
+		name   string
+		panes  int
+		layout string
+	}{
+		{state.LayoutEvenHorizontal, 1, "p"},
+		{state.LayoutEvenHorizontal, 3, "{1:p,1:p,1:p}"},
+		{state.LayoutEvenVertical, 2, "[1:p,1:p]"},
+		{state.LayoutMainHorizontal, 3, "[2:p,1:{1:p,1:p}]"},
+		{state.LayoutMainVertical, 3, "{2:p,1:[1:p,1:p]}"},
+		{state.LayoutMainVertical, 2, "{2:p,1:p}"},
+		{state.LayoutTiled, 4, "[1:{1:p,1:p},1:{1:p,1:p}]"},
+		{state.LayoutTiled, 5, "[1:{1:p,1:p,1:p},1:{1:p,1:p}]"},
+	} {
+		l, err := parseLayout(tc.name, tc.panes)
+		if err != nil {
+			t.Errorf("%s with %d panes: %s", tc.name, tc.panes, err)
+			continue
+		}
+		if got := l.String(); got != tc.layout {
+			t.Errorf("%s with %d panes: expected %s, got %s", tc.name, tc.panes, tc.layout, got)
+		}
+
+		// Named layouts serialize to layout strings that reproduce them.
+		rl, err := parseLayout(l.String(), tc.panes)
+		if err != nil {
+			t.Errorf("%s with %d panes: %s", tc.name, tc.panes, err)
+			continue
+		}
+		if got := rl.String(); got != tc.layout {
+			t.Errorf("%s with %d panes: reparsed as %s", tc.name, tc.panes, got)
+		}
+	}
+}
+
+func TestParseLayoutErrors(t *testing.T) {
+	for _, tc := range []struct {
+		layout string
+		panes  int
+		err    string
+	}{
+		{"", 1, "unknown layout"},
+		{"x", 1, "unknown layout"},
+		{"[1:x,1:p]", 2, "expected 'p', '{' or '['"},
+		{"{1:p}", 1, "at least two children"},
+		{"{1:p,1:p", 2, "unexpected end of layout"},
+		{"{1:p;1:p}", 2, "expected ',' or '}'"},
+		{"{p}", 1, "expected weight"},
+		{"{p,1:p}", 2, "invalid weight \"p,1\""},
+		{"{0:p,1:p}", 2, "invalid weight \"0\""},
+		{"{a:p,1:p}", 2, "invalid weight \"a\""},
+		{"{1:p,1:p}}", 2, "unexpected \"}\""},
+		{"{1:p,1:p}", 3, "has 2 panes but there are 3"},
+		{"spiral", 2, "unknown layout \"spiral\""},
+		{state.LayoutTiled, 0, "no panes"},
+	} {
+		_, err := parseLayout(tc.layout, tc.panes)
+		if err == nil || !strings.Contains(err.Error(), tc.err) {
+			t.Errorf("%q: expected error containing %q, got %v", tc.layout, tc.err, err)
+		}
+	}
+}
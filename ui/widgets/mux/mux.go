@@ -2,9 +2,12 @@ package mux
 
 import (
 	"fmt"
+	"sync/atomic"
+	"time"
 
 	"github.com/gcla/gowid"
 	tcell "github.com/gdamore/tcell/v2"
+	"github.com/hinshun/ptmux/pkg/vt"
 	"github.com/hinshun/ptmux/rvt"
 	"github.com/hinshun/ptmux/ui/wid"
 	"github.com/hinshun/ptmux/ui/widgets/columns"
@@ -22,42 +25,125 @@ type IMux interface {
 	VerticalSplit(id string, p *pane.Widget, app gowid.IApp)
 	HorizontalSplit(id string, p *pane.Widget, app gowid.IApp)
 	KillPane(id string, p *pane.Widget, app gowid.IApp)
+	RemovePane(id string, p *pane.Widget, app gowid.IApp)
+	InsertPane(id string, target, p *pane.Widget, vertical bool, app gowid.IApp)
+	SwapPanes(id string, p, q *pane.Widget, app gowid.IApp)
+	ReplacePane(p, q *pane.Widget, app gowid.IApp)
+	RotatePanes(id string, app gowid.IApp)
+	ToggleZoom(id string, p *pane.Widget, app gowid.IApp)
+	DisplayPanes(id string, app gowid.IApp)
+	SelectPane(id string, p *pane.Widget, app gowid.IApp)
+	Layout() string
+	SelectLayout(id string, layout string, app gowid.IApp) error
+	NextLayout(id string, app gowid.IApp)
 }
 
+// DisplayPanesTime is how long pane numbers are shown after Ctrl+b q.
+var DisplayPanesTime = 2 * time.Second
+
+// nextID is the stable ID given to the next window created.
+var nextID int32 = -1
+
+// Empty is the callback identifier for when the last pane is removed.
+type Empty struct{}
+
 func (w *Widget) NewPane(id string) *pane.Widget {
-	p := pane.New(w.defaultID, id)
+	p := pane.New(w.defaultID, id, vt.Command{})
+	w.adopt(p)
+	return p
+}
 
+// adopt makes the mux responsible for removing p when its process exits,
+// taking over from any mux p was previously in.
+func (w *Widget) adopt(p *pane.Widget) {
 	term := p.GetTerminal()
-	if term != nil {
-		term.OnProcessExited(gowid.WidgetCallbackExt{"cb",
-			func(app gowid.IApp, _ gowid.IWidget, data ...interface{}) {
-				lastID := data[0].(string)
-				w.KillPane(lastID, p, app)
-			},
-		})
+	if term == nil {
+		return
 	}
 
-	return p
+	term.RemoveOnProcessExited(gowid.CallbackID{"mux"})
+	term.OnProcessExited(gowid.WidgetCallbackExt{"mux",
+		func(app gowid.IApp, _ gowid.IWidget, data ...interface{}) {
+			// Panes whose process failed stay open so that the exit status
+			// remains visible in the frame until the pane is killed.
+			status := data[1].(vt.ExitStatus)
+			if !status.Success() {
+				return
+			}
+			lastID := data[0].(string)
+			w.KillPane(lastID, p, app)
+		},
+	})
 }
 
 type Widget struct {
 	gowid.IWidget
+	Callbacks *gowid.Callbacks
+	id        int
 	defaultID string
+	zoomed    map[string]*pane.Widget
+	numbered  map[string]*time.Timer
+	layoutIdx int
 }
 
 var _ gowid.IWidget = (*Widget)(nil)
+var _ wid.IViews = (*Widget)(nil)
+var _ wid.IForget = (*Widget)(nil)
 
 func New(defaultID string) *Widget {
-	w := &Widget{defaultID: defaultID}
+	w := NewWithPane(defaultID, nil)
 	w.IWidget = w.NewPane(defaultID)
 	return w
 }
 
+// NewWithPane returns a mux with p as its only pane.
+func NewWithPane(defaultID string, p *pane.Widget) *Widget {
+	w := &Widget{
+		Callbacks: gowid.NewCallbacks(),
+		id:        int(atomic.AddInt32(&nextID, 1)),
+		defaultID: defaultID,
+		zoomed:    make(map[string]*pane.Widget),
+		numbered:  make(map[string]*time.Timer),
+		layoutIdx: -1,
+	}
+	if p != nil {
+		p.SetDimension(gowid.RenderWithWeight{1})
+		w.adopt(p)
+		w.IWidget = p
+	}
+	return w
+}
+
+// ID returns the stable ID of the window, which is unique for the lifetime of
+// the session and doesn't change as other windows are opened and closed.
+func (w *Widget) ID() int {
+	return w.id
+}
+
+// OnEmpty registers a callback for when the last pane is removed. If there
+// are no callbacks, removing the last pane quits the app.
+func (w *Widget) OnEmpty(f gowid.IWidgetChangedCallback) {
+	gowid.AddWidgetCallback(w.Callbacks, Empty{}, f)
+}
+
+// Contains returns true if p is a pane in the mux.
+func (w *Widget) Contains(p *pane.Widget) bool {
+	for _, wp := range w.Panes() {
+		if wp == p {
+			return true
+		}
+	}
+	return false
+}
+
 func (w *Widget) String() string {
 	return fmt.Sprintf("%s", w.IWidget)
 }
 
 func (w *Widget) FocusedPane(id string) *pane.Widget {
+	if p, ok := w.zoomed[id]; ok {
+		return p
+	}
 	return findFocusedPane(id, w.IWidget)
 }
 
@@ -90,13 +176,157 @@ func (w *Widget) SubWidgetSize(size gowid.IRenderSize, focus gowid.Selector, app
 	return w.SubWidget().RenderSize(size, focus, app)
 }
 
+func (w *Widget) CustomView(id string) bool {
+	_, numbered := w.numbered[id]
+	_, hostNumbered := w.numbered[w.defaultID]
+	return w.zoomed[id] != w.zoomed[w.defaultID] || numbered != hostNumbered
+}
+
+// PaneByID returns the pane with the stable ID pid, or nil if it isn't in the
+// mux.
+func (w *Widget) PaneByID(pid int) *pane.Widget {
+	for _, p := range w.Panes() {
+		if p.ID() == pid {
+			return p
+		}
+	}
+	return nil
+}
+
+// DisplayPanes draws the index of every pane over it for the peer id until
+// DisplayPanesTime passes or id presses a key. Pressing a digit selects the
+// pane with that index.
+func (w *Widget) DisplayPanes(id string, app gowid.IApp) {
+	w.hidePanes(id)
+
+	var t *time.Timer
+	t = time.AfterFunc(DisplayPanesTime, func() {
+		app.Run(gowid.RunFunction(func(app gowid.IApp) {
+			if w.numbered[id] == t {
+				delete(w.numbered, id)
+				app.Redraw()
+			}
+		}))
+	})
+	w.numbered[id] = t
+}
+
+func (w *Widget) hidePanes(id string) {
+	if t, ok := w.numbered[id]; ok {
+		t.Stop()
+		delete(w.numbered, id)
+	}
+}
+
+// paneNumbers maps every pane to its index in depth-first order.
+func (w *Widget) paneNumbers() map[gowid.IWidget]int {
+	numbers := make(map[gowid.IWidget]int)
+	for i, p := range w.Panes() {
+		numbers[p] = i
+	}
+	return numbers
+}
+
+// SelectPane moves the focus of the peer id onto p, leaving zoom if p isn't
+// the zoomed pane.
+func (w *Widget) SelectPane(id string, p *pane.Widget, app gowid.IApp) {
+	if p == nil || !w.Contains(p) {
+		return
+	}
+	if zp, ok := w.zoomed[id]; ok && zp != p {
+		w.unzoom(id)
+	}
+	w.FocusPane(id, p)
+}
+
+// ToggleZoom renders p over the whole mux for the peer id, or restores the
+// layout if id already has a pane zoomed. Other peers keep seeing the layout.
+func (w *Widget) ToggleZoom(id string, p *pane.Widget, app gowid.IApp) {
+	if p == nil {
+		return
+	}
+
+	if _, ok := w.zoomed[id]; ok {
+		w.unzoom(id)
+		return
+	}
+
+	// There is nothing to zoom if the pane is the only pane.
+	if w.IWidget == p {
+		return
+	}
+
+	w.zoomed[id] = p
+	p.SetZoomed(true)
+}
+
+// Forget drops the zoom, pane numbers and focus of the peer id.
+func (w *Widget) Forget(id string) {
+	w.unzoom(id)
+	w.hidePanes(id)
+	wid.Forget(w.IWidget, id)
+}
+
+func (w *Widget) unzoom(id string) {
+	p, ok := w.zoomed[id]
+	if !ok {
+		return
+	}
+	delete(w.zoomed, id)
+
+	for _, zp := range w.zoomed {
+		if zp == p {
+			return
+		}
+	}
+	p.SetZoomed(false)
+}
+
+func (w *Widget) Render(size gowid.IRenderSize, focus gowid.Selector, app gowid.IApp) gowid.ICanvas {
+	viewer := wid.Viewer(app, w.defaultID)
+	if _, ok := w.numbered[viewer]; ok {
+		app = wid.WithPaneNumbers(app, w.paneNumbers())
+	}
+	if p, ok := w.zoomed[viewer]; ok {
+		return p.Render(size, focus, wid.WithZoom(wid.WithFocus(app, []string{viewer})))
+	}
+	return w.IWidget.Render(size, focus, app)
+}
+
 func (w *Widget) VerticalSplit(id string, p *pane.Widget, app gowid.IApp) {
 	if p == nil {
 		return
 	}
+	w.verticalSplit(id, p, w.NewPane(id), app)
+}
+
+func (w *Widget) HorizontalSplit(id string, p *pane.Widget, app gowid.IApp) {
+	if p == nil {
+		return
+	}
+	w.horizontalSplit(id, p, w.NewPane(id), app)
+}
+
+// InsertPane splits target and places p, which must not be in any mux, in
+// the new half.
+func (w *Widget) InsertPane(id string, target, p *pane.Widget, vertical bool, app gowid.IApp) {
+	if target == nil || p == nil {
+		return
+	}
+	p.SetDimension(gowid.RenderWithWeight{1})
+	w.adopt(p)
+	if vertical {
+		w.verticalSplit(id, target, p, app)
+	} else {
+		w.horizontalSplit(id, target, p, app)
+	}
+}
+
+func (w *Widget) verticalSplit(id string, p, newPane *pane.Widget, app gowid.IApp) {
+	w.unzoom(id)
 	parent := FindParentInHierarchy(w.IWidget, MatchWidget(p))
 
-	widgets := []gowid.IWidget{p, w.NewPane(id)}
+	widgets := []gowid.IWidget{p, newPane}
 	containers := make([]gowid.IContainerWidget, len(widgets))
 	for i, widget := range widgets {
 		containers[i] = widget.(gowid.IContainerWidget)
@@ -130,13 +360,11 @@ func (w *Widget) VerticalSplit(id string, p *pane.Widget, app gowid.IApp) {
 	w.split(id, parent, p, app, widgets)
 }
 
-func (w *Widget) HorizontalSplit(id string, p *pane.Widget, app gowid.IApp) {
-	if p == nil {
-		return
-	}
+func (w *Widget) horizontalSplit(id string, p, newPane *pane.Widget, app gowid.IApp) {
+	w.unzoom(id)
 	parent := FindParentInHierarchy(w.IWidget, MatchWidget(p))
 
-	widgets := []gowid.IWidget{p, w.NewPane(id)}
+	widgets := []gowid.IWidget{p, newPane}
 	containers := make([]gowid.IContainerWidget, len(widgets))
 	for i, widget := range widgets {
 		containers[i] = widget.(gowid.IContainerWidget)
@@ -174,16 +402,38 @@ func (w *Widget) HorizontalSplit(id string, p *pane.Widget, app gowid.IApp) {
 }
 
 func (w *Widget) KillPane(id string, p *pane.Widget, app gowid.IApp) {
-	if p == nil {
+	// The pane may have already been removed, in which case its process
+	// exiting is the result of the earlier kill.
+	if p == nil || !w.Contains(p) {
+		return
+	}
+	p.Kill()
+	w.RemovePane(id, p, app)
+}
+
+// RemovePane removes p from the tree without killing its process.
+func (w *Widget) RemovePane(id string, p *pane.Widget, app gowid.IApp) {
+	if p == nil || !w.Contains(p) {
 		return
 	}
+	for zid, zp := range w.zoomed {
+		if zp == p {
+			delete(w.zoomed, zid)
+		}
+	}
+	p.SetZoomed(false)
 	parent := FindParentInHierarchy(w.IWidget, MatchWidget(p))
 
 	// If there is only one pane, then parent will be nil.
 	if parent == nil {
-		app.Quit()
+		if _, ok := w.Callbacks.CopyOfCallbacks(Empty{}); !ok {
+			app.Quit()
+			return
+		}
+		gowid.RunWidgetCallbacks(w.Callbacks, Empty{}, app, w)
 		return
 	}
+
 	i, _ := FindNextWidgetFrom(parent.(gowid.ICompositeMultiple), func(w gowid.IWidget) bool {
 		return w == p
 	})
@@ -305,11 +555,6 @@ func MatchWidget(m gowid.IWidget) WidgetsPredicate {
 }
 
 func (w *Widget) UserInput(ev interface{}, size gowid.IRenderSize, focus gowid.Selector, app gowid.IApp) bool {
-	handled := gowid.UserInputIfSelectable(w.SubWidget(), ev, size, focus, app)
-	if handled {
-		return true
-	}
-
 	evt := ev
 	id := w.defaultID
 	if evr, ok := ev.(*rvt.RemoteEvent); ok {
@@ -317,8 +562,35 @@ func (w *Widget) UserInput(ev interface{}, size gowid.IRenderSize, focus gowid.S
 		id = evr.ID
 	}
 
+	// While pane numbers are displayed, the next key either selects a pane or
+	// dismisses them.
+	if evk, ok := evt.(*tcell.EventKey); ok {
+		if _, numbered := w.numbered[id]; numbered {
+			w.hidePanes(id)
+			if r := evk.Rune(); evk.Key() == tcell.KeyRune && r >= '0' && r <= '9' {
+				if panes := w.Panes(); int(r-'0') < len(panes) {
+					w.SelectPane(id, panes[r-'0'], app)
+				}
+				return true
+			}
+		}
+	}
+
+	var handled bool
+	if p, ok := w.zoomed[id]; ok {
+		handled = gowid.UserInputIfSelectable(p, ev, size, focus, wid.WithZoom(wid.WithFocus(app, []string{id})))
+	} else {
+		handled = gowid.UserInputIfSelectable(w.SubWidget(), ev, size, focus, app)
+	}
+	if handled {
+		return true
+	}
+
 	if evk, ok := evt.(*tcell.EventKey); ok {
 		switch evk.Key() {
+		case tcell.KeyCtrlO:
+			handled = true
+			w.RotatePanes(id, app)
 		case tcell.KeyRune:
 			handled = true
 			switch evk.Rune() {
@@ -328,6 +600,20 @@ func (w *Widget) UserInput(ev interface{}, size gowid.IRenderSize, focus gowid.S
 				w.HorizontalSplit(id, w.FocusedPane(id), app)
 			case 'x':
 				w.KillPane(id, w.FocusedPane(id), app)
+			case 'z':
+				w.ToggleZoom(id, w.FocusedPane(id), app)
+			case 'P':
+				if p := w.FocusedPane(id); p != nil {
+					p.TogglePipe()
+				}
+			case 'q':
+				w.DisplayPanes(id, app)
+			case ' ':
+				w.NextLayout(id, app)
+			case '{':
+				w.SwapPanes(id, w.FocusedPane(id), w.SiblingPane(w.FocusedPane(id), -1), app)
+			case '}':
+				w.SwapPanes(id, w.FocusedPane(id), w.SiblingPane(w.FocusedPane(id), 1), app)
 			default:
 				handled = false
 			}
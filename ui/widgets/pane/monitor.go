@@ -0,0 +1,180 @@
+package pane
+
+import (
+	"strings"
+	"time"
+
+	"github.com/gcla/gowid"
+	"github.com/hinshun/ptmux/pkg/state"
+	"github.com/hinshun/ptmux/rvt"
+)
+
+// Alert is something that happened in a pane while no one was looking at it.
+type Alert int
+
+const (
+	AlertBell Alert = 1 << iota
+	AlertActivity
+	AlertSilence
+)
+
+var alertNames = []struct {
+	alert Alert
+	name  string
+	flag  string
+}{
+	{AlertBell, "bell", "!"},
+	{AlertActivity, "activity", "#"},
+	{AlertSilence, "silence", "~"},
+}
+
+func (a Alert) String() string {
+	var names []string
+	for _, an := range alertNames {
+		if a&an.alert != 0 {
+			names = append(names, an.name)
+		}
+	}
+	return strings.Join(names, ",")
+}
+
+// Flags returns the alerts as the flags shown in the status line: "!" for a
+// bell, "#" for activity and "~" for silence.
+func (a Alert) Flags() string {
+	flags := ""
+	for _, an := range alertNames {
+		if a&an.alert != 0 {
+			flags += an.flag
+		}
+	}
+	return flags
+}
+
+// monitor watches the process in a pane for the alerts chosen by its options.
+type monitor struct {
+	activity bool
+	bell     bool
+	silence  time.Duration
+
+	alerts       Alert
+	silenceTimer *time.Timer
+}
+
+func newMonitor() monitor {
+	return monitor{bell: true}
+}
+
+func (m *monitor) set(name, value string) error {
+	err := state.ValidateOption(name, value)
+	if err != nil {
+		return err
+	}
+
+	switch name {
+	case state.OptionMonitorActivity, state.OptionMonitorBell:
+		on, _ := state.ParseSwitch(value)
+		if name == state.OptionMonitorActivity {
+			m.activity = on
+		} else {
+			m.bell = on
+		}
+	case state.OptionMonitorSilence:
+		d, _ := state.ParseSeconds(value)
+		m.silence = d
+		if d == 0 && m.silenceTimer != nil {
+			m.silenceTimer.Stop()
+		}
+	}
+	return nil
+}
+
+// options returns the options that differ from their defaults.
+func (m *monitor) options() map[string]string {
+	opts := make(map[string]string)
+	if m.activity {
+		opts[state.OptionMonitorActivity] = "on"
+	}
+	if !m.bell {
+		opts[state.OptionMonitorBell] = "off"
+	}
+	if m.silence > 0 {
+		opts[state.OptionMonitorSilence] = m.silence.String()
+	}
+	if len(opts) == 0 {
+		return nil
+	}
+	return opts
+}
+
+// SetOption sets an option of the pane, such as monitor-activity.
+func (w *Widget) SetOption(name, value string) error {
+	return w.monitor.set(name, value)
+}
+
+// Options returns the options of the pane that differ from their defaults.
+func (w *Widget) Options() map[string]string {
+	return w.monitor.options()
+}
+
+// Alerts returns the alerts raised since the pane was last focused.
+func (w *Widget) Alerts() Alert {
+	return w.monitor.alerts
+}
+
+// ClearAlerts clears the alerts of the pane once a viewer has seen it.
+func (w *Widget) ClearAlerts() {
+	w.monitor.alerts = 0
+}
+
+// onOutput is called from the main loop each time the process writes output.
+func (w *Widget) onOutput(app gowid.IApp) {
+	m := &w.monitor
+	if m.activity {
+		m.alerts |= AlertActivity
+	}
+
+	if m.silence <= 0 {
+		return
+	}
+	// The timer is made once and pushed back by each output, rather than
+	// made anew for every read of a busy process.
+	if m.silenceTimer != nil {
+		m.silenceTimer.Reset(m.silence)
+		return
+	}
+	m.silenceTimer = time.AfterFunc(m.silence, func() {
+		app.Run(gowid.RunFunction(func(app gowid.IApp) {
+			if m.silence > 0 && !w.Exited() {
+				m.alerts |= AlertSilence
+				app.Redraw()
+			}
+		}))
+	})
+}
+
+// onBell is called from the main loop each time the process rings the bell.
+func (w *Widget) onBell(app gowid.IApp, defaultID string) {
+	if !w.monitor.bell {
+		return
+	}
+	w.monitor.alerts |= AlertBell
+
+	app.GetScreen().Beep()
+	if n, ok := app.GetScreen().(rvt.Notifier); ok {
+		n.Notify(&rvt.ShareMessage{
+			Id: defaultID,
+			Message: &rvt.ShareMessage_Bell{
+				Bell: &rvt.BellMessage{
+					Pane: int32(w.id),
+				},
+			},
+		})
+	}
+}
+
+// stopMonitor stops watching for silence once the process has exited.
+func (w *Widget) stopMonitor() {
+	if w.monitor.silenceTimer != nil {
+		w.monitor.silenceTimer.Stop()
+	}
+}
@@ -0,0 +1,58 @@
+package pane
+
+import (
+	"strconv"
+
+	"github.com/gcla/gowid"
+)
+
+// digits is a 3x5 font used to draw pane numbers.
+var digits = [10][5]string{
+	{"###", "# #", "# #", "# #", "###"},
+	{"  #", "  #", "  #", "  #", "  #"},
+	{"###", "  #", "###", "#  ", "###"},
+	{"###", "  #", "###", "  #", "###"},
+	{"# #", "# #", "###", "  #", "  #"},
+	{"###", "#  ", "###", "  #", "###"},
+	{"###", "#  ", "###", "# #", "###"},
+	{"###", "  #", "  #", "  #", "  #"},
+	{"###", "# #", "###", "# #", "###"},
+	{"###", "# #", "###", "  #", "###"},
+}
+
+const (
+	digitWidth  = 3
+	digitHeight = 5
+)
+
+var numberCell = gowid.MakeCell(' ', gowid.ColorWhite, gowid.ColorBlue, gowid.StyleNone)
+
+// drawNumber draws n in large digits centered on the canvas, falling back to
+// plain digits if the canvas is too small.
+func drawNumber(canvas gowid.ICanvas, n int) {
+	s := strconv.Itoa(n)
+	cols, rows := canvas.BoxColumns(), canvas.BoxRows()
+
+	width := len(s)*(digitWidth+1) - 1
+	if width > cols || digitHeight > rows {
+		x, y := (cols-len(s))/2, rows/2
+		for i, r := range s {
+			if x+i >= 0 && x+i < cols {
+				canvas.SetCellAt(x+i, y, numberCell.WithRune(r))
+			}
+		}
+		return
+	}
+
+	x0, y0 := (cols-width)/2, (rows-digitHeight)/2
+	for i, r := range s {
+		glyph := digits[r-'0']
+		for y, line := range glyph {
+			for x, c := range line {
+				if c == '#' {
+					canvas.SetCellAt(x0+i*(digitWidth+1)+x, y0+y, numberCell)
+				}
+			}
+		}
+	}
+}
@@ -1,11 +1,19 @@
 package pane
 
 import (
+	"errors"
 	"fmt"
+	"strconv"
+	"sync/atomic"
 
 	"github.com/gcla/gowid"
 	"github.com/gcla/gowid/widgets/framed"
 	"github.com/gcla/gowid/widgets/text"
+	"github.com/hinshun/ptmux/pkg/hooks"
+	"github.com/hinshun/ptmux/pkg/state"
+	"github.com/hinshun/ptmux/pkg/vt"
+	"github.com/hinshun/ptmux/rvt"
+	"github.com/hinshun/ptmux/ui/wid"
 	"github.com/hinshun/ptmux/ui/widgets/styled"
 	"github.com/hinshun/ptmux/ui/widgets/terminal"
 )
@@ -14,18 +22,32 @@ var (
 	Frame = framed.FrameRunes{'┌', '┐', '└', '┘', '─', '─', '│', '│'}
 )
 
+// defaultTitle is the title of a pane until the program running in it sets
+// one.
+const defaultTitle = "~"
+
+// nextID is the stable ID given to the next pane created.
+var nextID int32 = -1
+
 type IWidget interface {
 	gowid.IWidget
 }
 
 type Widget struct {
 	*gowid.ContainerWidget
-	term *terminal.Widget
+	id      int
+	term    *terminal.Widget
+	frame   *framed.Widget
+	title   string
+	status  string
+	monitor monitor
+	pipe    Pipe
+	pipeErr error
 }
 
-func New(defaultID, lastID string) *Widget {
+func New(defaultID, lastID string, command vt.Command) *Widget {
 	var view gowid.IWidget
-	term, err := terminal.New(defaultID, lastID)
+	term, err := terminal.New(defaultID, lastID, command)
 	if err != nil {
 		view = text.New(err.Error())
 	} else {
@@ -34,7 +56,7 @@ func New(defaultID, lastID string) *Widget {
 
 	frame := framed.New(view, framed.Options{
 		Frame: Frame,
-		Title: "~",
+		Title: defaultTitle,
 	})
 
 	w := &Widget{
@@ -42,14 +64,66 @@ func New(defaultID, lastID string) *Widget {
 			IWidget: styled.New(defaultID, lastID, frame),
 			D:       gowid.RenderWithWeight{1},
 		},
-		term: term,
+		id:      int(atomic.AddInt32(&nextID, 1)),
+		term:    term,
+		frame:   frame,
+		title:   defaultTitle,
+		monitor: newMonitor(),
 	}
 
 	if term != nil {
+		term.SetCursorMark(wid.CursorMark(w.id))
+		term.OnProcessStarted(gowid.WidgetCallbackExt{"cb",
+			func(app gowid.IApp, _ gowid.IWidget, data ...interface{}) {
+				w.runHook(app, hooks.PaneCreate, nil)
+			},
+		})
+		term.OnOutput(gowid.WidgetCallbackExt{"cb",
+			func(app gowid.IApp, _ gowid.IWidget, data ...interface{}) {
+				w.onOutput(app)
+			},
+		})
+		term.OnBell(gowid.WidgetCallbackExt{"cb",
+			func(app gowid.IApp, _ gowid.IWidget, data ...interface{}) {
+				w.onBell(app, defaultID)
+			},
+		})
+		term.OnClipboard(gowid.WidgetCallbackExt{"cb",
+			func(app gowid.IApp, _ gowid.IWidget, data ...interface{}) {
+				w.onClipboard(app, defaultID, data[0].([]byte))
+			},
+		})
 		term.OnTitleChanged(gowid.WidgetCallbackExt{"cb",
-			func(app gowid.IApp, w gowid.IWidget, data ...interface{}) {
-				title := data[0].(string)
-				frame.SetTitle(title, app)
+			func(app gowid.IApp, _ gowid.IWidget, data ...interface{}) {
+				w.title = data[0].(string)
+				w.runHook(app, hooks.PaneTitle, nil)
+			},
+		})
+		term.OnProcessExited(gowid.WidgetCallbackExt{"cb",
+			func(app gowid.IApp, _ gowid.IWidget, data ...interface{}) {
+				status := data[1].(vt.ExitStatus)
+				if !status.Success() {
+					w.status = status.String()
+				}
+				w.stopMonitor()
+				w.pipe = Pipe{}
+				if n, ok := app.GetScreen().(rvt.Notifier); ok {
+					n.Notify(&rvt.ShareMessage{
+						Id: defaultID,
+						Message: &rvt.ShareMessage_Exit{
+							Exit: &rvt.ExitMessage{
+								Title:  w.title,
+								Code:   int32(status.Code),
+								Signal: status.SignalName(),
+								Pane:   int32(w.id),
+							},
+						},
+					})
+				}
+				w.runHook(app, hooks.PaneExit, map[string]string{
+					"PTMUX_EXIT_CODE":   strconv.Itoa(status.Code),
+					"PTMUX_EXIT_SIGNAL": status.SignalName(),
+				})
 			},
 		})
 	}
@@ -57,10 +131,193 @@ func New(defaultID, lastID string) *Widget {
 	return w
 }
 
+// onClipboard is called from the main loop each time the process sets the
+// clipboard, which is set on the terminal of the host and of every peer.
+func (w *Widget) onClipboard(app gowid.IApp, defaultID string, data []byte) {
+	if c, ok := app.GetScreen().(rvt.Clipboard); ok {
+		c.SetClipboard(data)
+	}
+	if n, ok := app.GetScreen().(rvt.Notifier); ok {
+		n.Notify(&rvt.ShareMessage{
+			Id: defaultID,
+			Message: &rvt.ShareMessage_Clipboard{
+				Clipboard: &rvt.ClipboardMessage{
+					Pane: int32(w.id),
+					Data: data,
+				},
+			},
+		})
+	}
+}
+
+// runHook runs the hooks for ev with the details of the pane added to vars.
+func (w *Widget) runHook(app gowid.IApp, ev hooks.Event, vars map[string]string) {
+	r, ok := app.GetScreen().(hooks.Runner)
+	if !ok {
+		return
+	}
+	if vars == nil {
+		vars = make(map[string]string)
+	}
+	vars["PTMUX_PANE"] = fmt.Sprintf("%%%d", w.id)
+	vars["PTMUX_PANE_TITLE"] = w.title
+	r.RunHook(ev, vars)
+}
+
+func (w *Widget) Render(size gowid.IRenderSize, focus gowid.Selector, app gowid.IApp) gowid.ICanvas {
+	w.frame.SetTitle(w.frameTitle(app), app)
+	canvas := w.ContainerWidget.Render(size, focus, app)
+	if n, ok := wid.PaneNumber(app, w); ok {
+		drawNumber(canvas, n)
+	}
+	return canvas
+}
+
+func (w *Widget) frameTitle(app gowid.IApp) string {
+	title := w.title
+	if w.status != "" {
+		title = fmt.Sprintf("%s [%s]", title, w.status)
+	}
+	if wid.Zoomed(app) {
+		title = fmt.Sprintf("%s [zoom]", title)
+	}
+	if w.pipeErr != nil {
+		title = fmt.Sprintf("%s [pipe: %s]", title, w.pipeErr)
+	} else if w.pipe.Piping() {
+		title = fmt.Sprintf("%s [pipe]", title)
+	}
+	if alerts := w.monitor.alerts; alerts != 0 {
+		title = fmt.Sprintf("%s [%s]", title, alerts)
+	}
+	return title
+}
+
 func (w *Widget) String() string {
 	return fmt.Sprintf("pane[%s]", w.ContainerWidget)
 }
 
+// ID returns the stable ID of the pane, which is unique for the lifetime of
+// the session and doesn't change as the pane moves between layouts and
+// windows.
+func (w *Widget) ID() int {
+	return w.id
+}
+
 func (w *Widget) GetTerminal() *terminal.Widget {
 	return w.term
 }
+
+// Title returns the title of the pane.
+func (w *Widget) Title() string {
+	return w.title
+}
+
+// Status returns how the process in the pane exited, if it failed.
+func (w *Widget) Status() string {
+	return w.status
+}
+
+// Size returns the size of the terminal in the pane.
+func (w *Widget) Size() (cols, rows int) {
+	if w.term == nil {
+		return 0, 0
+	}
+	return w.term.Size()
+}
+
+// SendKeys types b into the pane as if a peer had typed it.
+func (w *Widget) SendKeys(b []byte) error {
+	if w.term == nil || !w.term.Connected() {
+		return errors.New("pane has not started")
+	}
+	if w.term.Exited() {
+		return errors.New("pane has exited")
+	}
+	_, err := w.term.Write(b)
+	return err
+}
+
+// HidesInput returns true if the terminal in the pane is reading input it
+// doesn't echo, as at a password prompt.
+func (w *Widget) HidesInput() bool {
+	if w.term == nil {
+		return false
+	}
+	return w.term.HidesInput()
+}
+
+// LineEditing returns true if the process in the pane appears to be editing a
+// line of input, so that printable keys are echoed at the cursor.
+func (w *Widget) LineEditing() bool {
+	if w.term == nil {
+		return false
+	}
+	return w.term.LineEditing()
+}
+
+// Capture returns the contents of the pane as text.
+func (w *Widget) Capture(escapes, history bool) string {
+	if w.term == nil {
+		return ""
+	}
+	return w.term.Capture(escapes, history)
+}
+
+// SubscribeOutput sends the output of the process in the pane to ch. It
+// returns false if the process hasn't been started yet.
+func (w *Widget) SubscribeOutput(id string, ch chan string) bool {
+	if w.term == nil {
+		return false
+	}
+	return w.term.SubscribeOutput(id, ch)
+}
+
+// UnsubscribeOutput stops sending output to the channel subscribed as id.
+func (w *Widget) UnsubscribeOutput(id string) {
+	if w.term != nil {
+		w.term.UnsubscribeOutput(id)
+	}
+}
+
+// SetTitle sets the title of the pane until the program running in it sets
+// one.
+func (w *Widget) SetTitle(title string) {
+	w.title = title
+}
+
+// Exited returns true if the process running in the pane has exited.
+func (w *Widget) Exited() bool {
+	return w.term == nil || w.term.Exited()
+}
+
+// State returns the state needed to restart the pane.
+func (w *Widget) State() state.Pane {
+	st := state.Pane{
+		Options: w.Options(),
+	}
+	if w.title != defaultTitle {
+		st.Title = w.title
+	}
+	if w.term != nil {
+		st.Command = w.term.Command().Args
+		st.Env = w.term.Command().Env
+		st.Cwd = w.term.Cwd()
+	}
+	return st
+}
+
+// SetZoomed pins the pty size of the pane while one or more peers have it
+// zoomed.
+func (w *Widget) SetZoomed(zoomed bool) {
+	if w.term != nil {
+		w.term.SetZoomed(zoomed)
+	}
+}
+
+// Kill hangs up the process running in the pane, if any.
+func (w *Widget) Kill() error {
+	if w.term == nil {
+		return nil
+	}
+	return w.term.Kill()
+}
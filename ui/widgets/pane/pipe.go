@@ -0,0 +1,129 @@
+package pane
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"time"
+)
+
+// Pipe is where the output of a pane is piped, like tmux's pipe-pane.
+type Pipe struct {
+	// Command is run with $SHELL -c, or /bin/sh if $SHELL is unset, and
+	// given the output on its stdin.
+	Command string
+
+	// Path is a file the output is appended to, used if Command is empty.
+	Path string
+
+	// Strip removes escape sequences from the output, leaving only its text.
+	Strip bool
+}
+
+// Piping returns true if the pipe has somewhere to write output.
+func (p Pipe) Piping() bool {
+	return p.Command != "" || p.Path != ""
+}
+
+func (p Pipe) String() string {
+	s := p.Command
+	if s == "" {
+		s = "> " + p.Path
+	}
+	if p.Strip {
+		s += " (stripped)"
+	}
+	return s
+}
+
+// open starts the command or opens the file of the pipe.
+func (p Pipe) open(env []string) (io.WriteCloser, error) {
+	if p.Command == "" {
+		return os.OpenFile(p.Path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0644)
+	}
+
+	shell := os.Getenv("SHELL")
+	if shell == "" {
+		shell = "/bin/sh"
+	}
+
+	cmd := exec.Command(shell, "-c", p.Command)
+	cmd.Env = append(os.Environ(), env...)
+	stdin, err := cmd.StdinPipe()
+	if err != nil {
+		return nil, err
+	}
+	err = cmd.Start()
+	if err != nil {
+		return nil, err
+	}
+	return &commandWriter{WriteCloser: stdin, cmd: cmd}, nil
+}
+
+// commandWriter writes to the stdin of a command, which is waited for once
+// its stdin is closed.
+type commandWriter struct {
+	io.WriteCloser
+	cmd *exec.Cmd
+}
+
+func (c *commandWriter) Close() error {
+	err := c.WriteCloser.Close()
+	if werr := c.cmd.Wait(); err == nil {
+		err = werr
+	}
+	return err
+}
+
+// SetPipe pipes the output of the process in the pane to p from now on,
+// replacing the previous pipe. A Pipe with nowhere to write stops piping.
+func (w *Widget) SetPipe(p Pipe) error {
+	if w.term == nil || !w.term.Connected() {
+		return errors.New("pane has not started")
+	}
+	if w.term.Exited() {
+		return errors.New("pane has exited")
+	}
+
+	if !p.Piping() {
+		w.term.Pipe(nil, false)
+		w.pipe = Pipe{}
+		return nil
+	}
+
+	out, err := p.open([]string{
+		fmt.Sprintf("PTMUX_PANE=%%%d", w.id),
+		"PTMUX_PANE_TITLE=" + w.title,
+	})
+	if err != nil {
+		return err
+	}
+	w.term.Pipe(out, p.Strip)
+	w.pipe = p
+	return nil
+}
+
+// TogglePipe appends the output of the pane to a new file in the current
+// directory, or stops the pipe in progress. If the pipe can't be started or
+// stopped, the error is shown in the title of the pane until it is toggled
+// again.
+func (w *Widget) TogglePipe() {
+	if w.pipe.Piping() {
+		w.pipeErr = w.SetPipe(Pipe{})
+		return
+	}
+	w.pipeErr = w.SetPipe(Pipe{Path: pipePath(w.id)})
+}
+
+// pipePath returns the file a pipe toggled on for the pane with the stable
+// ID id is appended to.
+func pipePath(id int) string {
+	return fmt.Sprintf("ptmux-pane-%d-%s.log", id, time.Now().Format("20060102-150405"))
+}
+
+// Pipe returns where the output of the pane is piped.
+func (w *Widget) Pipe() Pipe {
+	return w.pipe
+}
@@ -1,6 +1,8 @@
 package peerstyled
 
 import (
+	"sort"
+
 	"github.com/gcla/gowid"
 	tcell "github.com/gdamore/tcell/v2"
 	"github.com/hinshun/ptmux/rvt"
@@ -19,10 +21,12 @@ var (
 type Widget struct {
 	gowid.IWidget
 	defaultID    string
-	paletteIdx   int
 	palette      map[string]gowid.ICellStyler
 	clickTargets map[string]gowid.ClickTargets
 	lastMouse    map[string]gowid.MouseState
+
+	// marks holds the marks of the canvas last rendered for each viewer.
+	marks map[string]map[string]gowid.CanvasPos
 }
 
 func New(defaultID string, inner gowid.IWidget) *Widget {
@@ -32,26 +36,110 @@ func New(defaultID string, inner gowid.IWidget) *Widget {
 		palette:      make(map[string]gowid.ICellStyler),
 		clickTargets: make(map[string]gowid.ClickTargets),
 		lastMouse:    make(map[string]gowid.MouseState),
+		marks:        make(map[string]map[string]gowid.CanvasPos),
 	}
 	w.Add(defaultID)
 	return w
 }
 
 func (w *Widget) Add(id string) {
-	w.palette[id] = Stylers[w.paletteIdx]
-	w.paletteIdx++
+	w.palette[id] = w.nextStyler()
 	w.clickTargets[id] = gowid.MakeClickTargets()
 	w.lastMouse[id] = gowid.MouseState{}
 }
 
+// nextStyler returns the styler used by the fewest peers, so that colors of
+// peers that left are reused and peers share colors only once every color is
+// taken.
+func (w *Widget) nextStyler() gowid.ICellStyler {
+	used := make([]int, len(Stylers))
+	for _, styler := range w.palette {
+		for i := range Stylers {
+			if Stylers[i] == styler {
+				used[i]++
+			}
+		}
+	}
+
+	next := 0
+	for i := range Stylers {
+		if used[i] < used[next] {
+			next = i
+		}
+	}
+	return Stylers[next]
+}
+
+// Remove drops the palette of the peer id, along with its focus and any other
+// state the widgets kept for it.
 func (w *Widget) Remove(id string) {
 	delete(w.palette, id)
 	delete(w.clickTargets, id)
 	delete(w.lastMouse, id)
+	delete(w.marks, id)
+	wid.Forget(w.IWidget, id)
+}
+
+// Has returns true if the peer id has a palette.
+func (w *Widget) Has(id string) bool {
+	_, ok := w.palette[id]
+	return ok
+}
+
+// IDs returns the sorted ids of every peer with a palette.
+func (w *Widget) IDs() []string {
+	var ids []string
+	for id := range w.palette {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+func (w *Widget) CustomView(id string) bool {
+	if v, ok := w.IWidget.(wid.IViews); ok {
+		return v.CustomView(id)
+	}
+	return false
+}
+
+// Context returns app with the peers of the widget, for acting on the widget
+// hierarchy outside of rendering and user input.
+func (w *Widget) Context(app gowid.IApp) gowid.IApp {
+	return wid.WithP2PContext(app, w.palette, gowid.ClickTargets{}, gowid.MouseState{}, gowid.MouseState{})
+}
+
+// Cursor returns where the cursor of the pane the peer id has focused was last
+// rendered on its screen, and whether the pane appears to be editing a line.
+// It returns false if the cursor wasn't rendered.
+func (w *Widget) Cursor(id string) (x, y int, lineEditing, ok bool) {
+	c, ok := w.IWidget.(wid.ICursors)
+	if !ok {
+		return 0, 0, false, false
+	}
+	mark, lineEditing, ok := c.Cursor(id)
+	if !ok {
+		return 0, 0, false, false
+	}
+
+	viewer := w.defaultID
+	if w.CustomView(id) {
+		viewer = id
+	}
+	pos, ok := w.marks[viewer][mark]
+	return pos.X, pos.Y, lineEditing, ok
 }
 
 func (w *Widget) Render(size gowid.IRenderSize, focus gowid.Selector, app gowid.IApp) gowid.ICanvas {
-	return w.IWidget.Render(size, focus, wid.WithP2PContext(app, w.palette, gowid.ClickTargets{}, gowid.MouseState{}, gowid.MouseState{}))
+	canvas := w.IWidget.Render(size, focus, w.Context(app))
+
+	marks := make(map[string]gowid.CanvasPos)
+	canvas.RangeOverMarks(func(k string, pos gowid.CanvasPos) bool {
+		marks[k] = pos
+		return true
+	})
+	w.marks[wid.Viewer(app, w.defaultID)] = marks
+	return canvas
 }
 
 func (w *Widget) UserInput(ev interface{}, size gowid.IRenderSize, focus gowid.Selector, app gowid.IApp) bool {
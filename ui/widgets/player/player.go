@@ -0,0 +1,186 @@
+// Package player provides a widget for playing back recorded sessions.
+package player
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/gcla/gowid"
+	tcell "github.com/gdamore/tcell/v2"
+	"github.com/hinshun/ptmux/pkg/asciicast"
+	"github.com/hinshun/ptmux/rvt"
+	"github.com/hinshun/ptmux/ui/widgets/terminal"
+)
+
+// SeekStep is how far the arrow keys seek.
+const SeekStep = 5 * time.Second
+
+// Widget plays back a recording through a terminal, with a status line
+// showing the position in the recording. Only the host controls playback;
+// peers watch as spectators and their input is ignored.
+type Widget struct {
+	defaultID string
+	term      *terminal.Widget
+	player    *asciicast.Player
+	w         *io.PipeWriter
+	gowid.IsSelectable
+}
+
+var _ gowid.IWidget = (*Widget)(nil)
+
+func New(defaultID string, rec *asciicast.Recording) (*Widget, error) {
+	r, pw := io.Pipe()
+	term, err := terminal.NewReplay(defaultID, r, rec.Header.Width, rec.Header.Height)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Widget{
+		defaultID: defaultID,
+		term:      term,
+		player:    asciicast.NewPlayer(rec, pw, term.ResizeReplay),
+		w:         pw,
+	}, nil
+}
+
+func (w *Widget) String() string {
+	return "player"
+}
+
+// Play plays the recording until ctx is done, redrawing the status line as
+// the position changes.
+func (w *Widget) Play(ctx context.Context, app gowid.IApp) error {
+	defer w.w.Close()
+
+	go func() {
+		ticker := time.NewTicker(250 * time.Millisecond)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				app.Redraw()
+			}
+		}
+	}()
+
+	return w.player.Play(ctx)
+}
+
+func (w *Widget) RenderSize(size gowid.IRenderSize, focus gowid.Selector, app gowid.IApp) gowid.IRenderBox {
+	box, ok := size.(gowid.IRenderBox)
+	if !ok {
+		panic(gowid.WidgetSizeError{Widget: w, Size: size, Required: "gowid.IRenderBox"})
+	}
+	return gowid.RenderBox{C: box.BoxColumns(), R: box.BoxRows()}
+}
+
+func (w *Widget) Render(size gowid.IRenderSize, focus gowid.Selector, app gowid.IApp) gowid.ICanvas {
+	box, ok := size.(gowid.IRenderBox)
+	if !ok {
+		panic(gowid.WidgetSizeError{Widget: w, Size: size, Required: "gowid.IRenderBox"})
+	}
+	cols, rows := box.BoxColumns(), box.BoxRows()
+
+	var canvas gowid.ICanvas = gowid.NewCanvas()
+	if rows > 1 {
+		canvas = w.term.Render(gowid.RenderBox{C: cols, R: rows - 1}, focus, app)
+	}
+	canvas.AppendBelow(w.renderStatus(cols), false, false)
+	return canvas
+}
+
+// renderStatus renders the status line with the position in the recording,
+// the speed it is played at and the last marker, such as the peer typing.
+func (w *Widget) renderStatus(cols int) gowid.ICanvas {
+	canvas := gowid.NewCanvasOfSize(cols, 1)
+	x := 0
+	put := func(text string, style gowid.StyleAttrs) {
+		for _, r := range text {
+			if x >= cols {
+				return
+			}
+			canvas.SetCellAt(x, 0, gowid.MakeCell(r, gowid.ColorBlack, gowid.ColorGreen, style))
+			x++
+		}
+	}
+
+	state := "playing"
+	switch {
+	case w.player.Ended():
+		state = "ended"
+	case w.player.Paused():
+		state = "paused"
+	}
+	put(fmt.Sprintf(" [%s] %s / %s %gx",
+		state,
+		formatPosition(w.player.Position()),
+		formatPosition(w.player.Duration()),
+		w.player.Speed(),
+	), gowid.StyleNone)
+	if marker := w.player.Marker(); marker != "" {
+		put(" ("+marker+")", gowid.StyleBold)
+	}
+	put(strings.Repeat(" ", cols), gowid.StyleNone)
+	return canvas
+}
+
+func formatPosition(d time.Duration) string {
+	secs := int(d / time.Second)
+	return fmt.Sprintf("%d:%02d", secs/60, secs%60)
+}
+
+// UserInput controls playback: space pauses, the arrow keys seek, + and -
+// change the speed, and 0 or Home restarts the recording.
+func (w *Widget) UserInput(ev interface{}, size gowid.IRenderSize, focus gowid.Selector, app gowid.IApp) bool {
+	if _, ok := ev.(*rvt.RemoteEvent); ok {
+		// Spectators can't control playback.
+		return true
+	}
+
+	evk, ok := ev.(*tcell.EventKey)
+	if !ok {
+		return false
+	}
+
+	switch evk.Key() {
+	case tcell.KeyLeft:
+		w.seek(w.player.Position() - SeekStep)
+	case tcell.KeyRight:
+		w.seek(w.player.Position() + SeekStep)
+	case tcell.KeyHome:
+		w.seek(0)
+	case tcell.KeyEnd:
+		w.seek(w.player.Duration())
+	case tcell.KeyRune:
+		switch evk.Rune() {
+		case ' ':
+			w.player.SetPaused(!w.player.Paused())
+		case '+', '=':
+			w.player.SetSpeed(w.player.Speed() * 2)
+		case '-':
+			w.player.SetSpeed(w.player.Speed() / 2)
+		case '0':
+			w.seek(0)
+		case 'q':
+			app.Quit()
+		default:
+			return false
+		}
+	default:
+		return false
+	}
+
+	app.Redraw()
+	return true
+}
+
+// seek seeks outside the main loop, as the terminal needs the main loop to
+// render the output being skipped over.
+func (w *Widget) seek(pos time.Duration) {
+	go w.player.Seek(pos)
+}
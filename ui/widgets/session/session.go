@@ -0,0 +1,638 @@
+// Package session provides a widget for organizing panes into windows.
+package session
+
+import (
+	"fmt"
+	"os/exec"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gcla/gowid"
+	tcell "github.com/gdamore/tcell/v2"
+	"github.com/hinshun/ptmux/pkg/state"
+	"github.com/hinshun/ptmux/pkg/vt"
+	"github.com/hinshun/ptmux/rvt"
+	"github.com/hinshun/ptmux/ui/wid"
+	"github.com/hinshun/ptmux/ui/widgets/mux"
+	"github.com/hinshun/ptmux/ui/widgets/pane"
+)
+
+// Recorder is implemented by screens that can record the session.
+type Recorder interface {
+	ToggleRecording(app gowid.IApp)
+	Recording() bool
+	RecordingError() error
+}
+
+// Auditor is implemented by screens that keep an audit log of the input of
+// remote peers. Audit is called with each event after it has been applied,
+// along with the pane it was applied to, which may be nil.
+type Auditor interface {
+	Audit(ev *rvt.RemoteEvent, p *pane.Widget)
+}
+
+// PeerStatser is implemented by screens that measure the connection to each
+// peer. Screens redraw as the stats change while any peer is watching them.
+type PeerStatser interface {
+	PeerStats() map[string]rvt.PeerStats
+	WatchPeerStats(id string, watch bool)
+}
+
+type Widget struct {
+	defaultID string
+	windows   []*mux.Widget
+	current   map[string]int
+
+	// info holds the peers viewing the stats of the connected peers.
+	info map[string]bool
+
+	gowid.IsSelectable
+}
+
+var _ gowid.IWidget = (*Widget)(nil)
+var _ wid.IViews = (*Widget)(nil)
+var _ wid.IForget = (*Widget)(nil)
+var _ wid.ICursors = (*Widget)(nil)
+
+func New(defaultID string) *Widget {
+	w := &Widget{
+		defaultID: defaultID,
+		current:   make(map[string]int),
+		info:      make(map[string]bool),
+	}
+	w.addWindow(mux.New(defaultID))
+	return w
+}
+
+// NewFromState returns a session with the windows and panes of a saved
+// session. The pane commands are started when the panes are first rendered.
+func NewFromState(defaultID string, st *state.Session) (*Widget, error) {
+	err := st.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	w := &Widget{
+		defaultID: defaultID,
+		current:   make(map[string]int),
+		info:      make(map[string]bool),
+	}
+	for i, sw := range st.Windows {
+		panes := make([]*pane.Widget, len(sw.Panes))
+		for j, sp := range sw.Panes {
+			if len(sp.Command) > 0 {
+				_, err := exec.LookPath(sp.Command[0])
+				if err != nil {
+					return nil, fmt.Errorf("window %d pane %d: %w", i, j, err)
+				}
+			}
+
+			panes[j] = pane.New(defaultID, defaultID, vt.Command{
+				Args: sp.Command,
+				Dir:  sp.Cwd,
+				Env:  sp.Env,
+			})
+			if sp.Title != "" {
+				panes[j].SetTitle(sp.Title)
+			}
+			for name, value := range sp.Options {
+				err := panes[j].SetOption(name, value)
+				if err != nil {
+					return nil, fmt.Errorf("window %d pane %d: %w", i, j, err)
+				}
+			}
+		}
+
+		m, err := mux.NewWithLayout(defaultID, sw.Layout, panes)
+		if err != nil {
+			return nil, fmt.Errorf("window %d: %w", i, err)
+		}
+		m.FocusPane(defaultID, panes[sw.Active])
+		w.addWindow(m)
+	}
+	w.SelectWindow(defaultID, st.Current)
+	return w, nil
+}
+
+// State returns the state needed to restore the session.
+func (w *Widget) State() *state.Session {
+	st := &state.Session{
+		Current: w.CurrentWindow(w.defaultID),
+	}
+	for _, win := range w.windows {
+		st.Windows = append(st.Windows, win.State())
+	}
+	return st
+}
+
+// Exited returns true if the process in every pane of the session has
+// exited.
+func (w *Widget) Exited() bool {
+	for _, win := range w.windows {
+		for _, p := range win.Panes() {
+			if !p.Exited() {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func (w *Widget) String() string {
+	return fmt.Sprintf("session%v", w.windows)
+}
+
+// Windows returns every window in the session.
+func (w *Widget) Windows() []*mux.Widget {
+	return w.windows
+}
+
+// CurrentWindow returns the index of the window the peer id is viewing.
+// Peers that haven't picked a window follow the host.
+func (w *Widget) CurrentWindow(id string) int {
+	if i, ok := w.current[id]; ok {
+		return i
+	}
+	return w.current[w.defaultID]
+}
+
+// Window returns the window the peer id is viewing.
+func (w *Widget) Window(id string) *mux.Widget {
+	return w.windows[w.CurrentWindow(id)]
+}
+
+// WindowIndex returns the index of m, or -1 if m is not in the session.
+func (w *Widget) WindowIndex(m *mux.Widget) int {
+	for i, win := range w.windows {
+		if win == m {
+			return i
+		}
+	}
+	return -1
+}
+
+// WindowOf returns the window containing p.
+func (w *Widget) WindowOf(p *pane.Widget) *mux.Widget {
+	for _, win := range w.windows {
+		if win.Contains(p) {
+			return win
+		}
+	}
+	return nil
+}
+
+// PaneByID returns the pane with the stable ID pid and the window it is in.
+func (w *Widget) PaneByID(pid int) (*pane.Widget, *mux.Widget) {
+	for _, win := range w.windows {
+		if p := win.PaneByID(pid); p != nil {
+			return p, win
+		}
+	}
+	return nil, nil
+}
+
+// FindPane resolves a pane target for the peer id. A target is one of:
+//
+//	""     the pane id has focused
+//	"%N"   the pane with stable ID N, in any window
+//	"N"    the pane with index N in the window id is viewing
+//	"W.N"  the pane with index N in window W
+func (w *Widget) FindPane(id, target string) (*pane.Widget, error) {
+	if target == "" {
+		p := w.Window(id).FocusedPane(id)
+		if p == nil {
+			return nil, fmt.Errorf("no focused pane")
+		}
+		return p, nil
+	}
+
+	if strings.HasPrefix(target, "%") {
+		pid, err := strconv.Atoi(target[1:])
+		if err != nil {
+			return nil, fmt.Errorf("invalid pane id %q", target)
+		}
+		p, _ := w.PaneByID(pid)
+		if p == nil {
+			return nil, fmt.Errorf("no pane %s", target)
+		}
+		return p, nil
+	}
+
+	win := w.Window(id)
+	index := target
+	if i := strings.IndexByte(target, '.'); i >= 0 {
+		wi, err := strconv.Atoi(target[:i])
+		if err != nil || wi < 0 || wi >= len(w.windows) {
+			return nil, fmt.Errorf("no window %q", target[:i])
+		}
+		win, index = w.windows[wi], target[i+1:]
+	}
+
+	pi, err := strconv.Atoi(index)
+	if err != nil {
+		return nil, fmt.Errorf("invalid pane target %q", target)
+	}
+	panes := win.Panes()
+	if pi < 0 || pi >= len(panes) {
+		return nil, fmt.Errorf("no pane %q", target)
+	}
+	return panes[pi], nil
+}
+
+// SelectPane switches the peer id to the window containing p and focuses it.
+func (w *Widget) SelectPane(id string, p *pane.Widget, app gowid.IApp) {
+	win := w.WindowOf(p)
+	if win == nil {
+		return
+	}
+	w.current[id] = w.WindowIndex(win)
+	win.SelectPane(id, p, app)
+}
+
+// Cursor returns the mark at the cursor of the pane the peer id has focused,
+// and whether the pane appears to be editing a line.
+func (w *Widget) Cursor(id string) (string, bool, bool) {
+	p := w.Window(id).FocusedPane(id)
+	if p == nil {
+		return "", false, false
+	}
+	return wid.CursorMark(p.ID()), p.LineEditing(), true
+}
+
+// Forget drops the current window of the peer id and its state in every
+// window.
+func (w *Widget) Forget(id string) {
+	delete(w.current, id)
+	delete(w.info, id)
+	for _, win := range w.windows {
+		wid.Forget(win, id)
+	}
+}
+
+// ToggleInfo shows or hides the stats of the connected peers over the window
+// the peer id is viewing.
+func (w *Widget) ToggleInfo(id string, app gowid.IApp) {
+	if w.info[id] {
+		delete(w.info, id)
+	} else {
+		w.info[id] = true
+	}
+	if ps, ok := app.GetScreen().(PeerStatser); ok {
+		ps.WatchPeerStats(id, w.info[id])
+	}
+}
+
+func (w *Widget) SelectWindow(id string, i int) {
+	if i < 0 || i >= len(w.windows) {
+		return
+	}
+	w.current[id] = i
+}
+
+func (w *Widget) NextWindow(id string, offset int) {
+	n := len(w.windows)
+	w.SelectWindow(id, ((w.CurrentWindow(id)+offset)%n+n)%n)
+}
+
+// NewWindow creates a window with a single pane and switches the peer id to
+// it.
+func (w *Widget) NewWindow(id string) *mux.Widget {
+	m := mux.New(w.defaultID)
+	w.addWindow(m)
+	w.current[id] = len(w.windows) - 1
+	return m
+}
+
+func (w *Widget) addWindow(m *mux.Widget) {
+	m.OnEmpty(gowid.WidgetCallbackExt{"session",
+		func(app gowid.IApp, _ gowid.IWidget, data ...interface{}) {
+			w.closeWindow(m, app)
+		},
+	})
+	w.windows = append(w.windows, m)
+}
+
+// closeWindow removes an empty window, moving every peer viewing it to the
+// previous window. Closing the last window quits the app.
+func (w *Widget) closeWindow(m *mux.Widget, app gowid.IApp) {
+	i := w.WindowIndex(m)
+	if i < 0 {
+		return
+	}
+	if len(w.windows) == 1 {
+		app.Quit()
+		return
+	}
+
+	w.windows = append(w.windows[:i], w.windows[i+1:]...)
+	for id, cur := range w.current {
+		if cur > i || (cur == i && cur > 0) {
+			w.current[id] = cur - 1
+		}
+	}
+}
+
+// BreakPane moves p out of its window and into a new window of its own.
+func (w *Widget) BreakPane(id string, p *pane.Widget, app gowid.IApp) {
+	src := w.WindowOf(p)
+	if src == nil || len(src.Panes()) < 2 {
+		return
+	}
+
+	src.RemovePane(id, p, app)
+	w.addWindow(mux.NewWithPane(w.defaultID, p))
+	w.current[id] = len(w.windows) - 1
+}
+
+// JoinPane moves p into the window of target by splitting target.
+func (w *Widget) JoinPane(id string, p, target *pane.Widget, vertical bool, app gowid.IApp) error {
+	src, dst := w.WindowOf(p), w.WindowOf(target)
+	if src == nil || dst == nil {
+		return fmt.Errorf("pane is not in any window")
+	}
+	if src == dst {
+		return fmt.Errorf("pane is already in window %d", w.WindowIndex(dst))
+	}
+
+	// Removing the last pane closes the source window, which may shift the
+	// index of the destination window.
+	src.RemovePane(id, p, app)
+	dst.InsertPane(id, target, p, vertical, app)
+	w.current[id] = w.WindowIndex(dst)
+	return nil
+}
+
+// SwapPanes exchanges the positions of p and q, which may be in different
+// windows. Within a window, every peer's focus follows the pane it was
+// focused on. Across windows, peers focused on p are focused on q in its
+// place, and the other way around.
+func (w *Widget) SwapPanes(id string, p, q *pane.Widget, app gowid.IApp) error {
+	pw, qw := w.WindowOf(p), w.WindowOf(q)
+	if pw == nil || qw == nil {
+		return fmt.Errorf("pane is not in any window")
+	}
+	if p == q {
+		return nil
+	}
+	if pw == qw {
+		pw.SwapPanes(id, p, q, app)
+		return nil
+	}
+
+	pw.ReplacePane(p, q, app)
+	qw.ReplacePane(q, p, app)
+	return nil
+}
+
+func (w *Widget) CustomView(id string) bool {
+	if w.CurrentWindow(id) != w.CurrentWindow(w.defaultID) || w.info[id] != w.info[w.defaultID] {
+		return true
+	}
+	return w.Window(id).CustomView(id)
+}
+
+func (w *Widget) RenderSize(size gowid.IRenderSize, focus gowid.Selector, app gowid.IApp) gowid.IRenderBox {
+	box, ok := size.(gowid.IRenderBox)
+	if !ok {
+		panic(gowid.WidgetSizeError{Widget: w, Size: size, Required: "gowid.IRenderBox"})
+	}
+	return gowid.RenderBox{C: box.BoxColumns(), R: box.BoxRows()}
+}
+
+func (w *Widget) Render(size gowid.IRenderSize, focus gowid.Selector, app gowid.IApp) gowid.ICanvas {
+	box, ok := size.(gowid.IRenderBox)
+	if !ok {
+		panic(gowid.WidgetSizeError{Widget: w, Size: size, Required: "gowid.IRenderBox"})
+	}
+	cols, rows := box.BoxColumns(), box.BoxRows()
+	viewer := wid.Viewer(app, w.defaultID)
+
+	// The viewer is looking at the focused pane, so its alerts have been
+	// seen.
+	if p := w.Window(viewer).FocusedPane(viewer); p != nil {
+		p.ClearAlerts()
+	}
+
+	var canvas gowid.ICanvas = gowid.NewCanvas()
+	if rows > 1 {
+		canvas = w.Window(viewer).Render(gowid.RenderBox{C: cols, R: rows - 1}, focus, app)
+		if w.info[viewer] {
+			w.renderInfo(canvas, cols, rows-1, app)
+		}
+	}
+	canvas.AppendBelow(w.renderStatus(viewer, cols, app), false, false)
+	return canvas
+}
+
+// renderStatus renders the status line listing every window, with the
+// window the viewer is on marked with an asterisk. Windows with panes that
+// have raised alerts are followed by their flags and highlighted.
+func (w *Widget) renderStatus(viewer string, cols int, app gowid.IApp) gowid.ICanvas {
+	canvas := gowid.NewCanvasOfSize(cols, 1)
+	x := 0
+	put := func(text string, style gowid.StyleAttrs) {
+		for _, r := range text {
+			if x >= cols {
+				return
+			}
+			canvas.SetCellAt(x, 0, gowid.MakeCell(r, gowid.ColorBlack, gowid.ColorGreen, style))
+			x++
+		}
+	}
+
+	for i, win := range w.windows {
+		title := ""
+		if p := win.FocusedPane(viewer); p != nil {
+			title = p.Title()
+		}
+		flag := " "
+		if i == w.CurrentWindow(viewer) {
+			flag = "*"
+		}
+
+		var alerts pane.Alert
+		for _, p := range win.Panes() {
+			alerts |= p.Alerts()
+		}
+
+		put(" ", gowid.StyleNone)
+		if alerts != 0 {
+			put(fmt.Sprintf("%d:%s%s%s", i, title, flag, alerts.Flags()), gowid.StyleReverse)
+		} else {
+			put(fmt.Sprintf("%d:%s%s", i, title, flag), gowid.StyleNone)
+		}
+	}
+	if r, ok := app.GetScreen().(Recorder); ok {
+		if err := r.RecordingError(); err != nil {
+			put(" [rec: "+err.Error()+"]", gowid.StyleReverse)
+		} else if r.Recording() {
+			put(" [rec]", gowid.StyleBold)
+		}
+	}
+	put(strings.Repeat(" ", cols), gowid.StyleNone)
+	return canvas
+}
+
+// renderInfo renders a table of the connected peers in the middle of canvas,
+// with the round-trip time, connection type and the rates each is sent bytes
+// and frames at.
+func (w *Widget) renderInfo(canvas gowid.ICanvas, cols, rows int, app gowid.IApp) {
+	lines := []string{fmt.Sprintf("%-14s %7s %-12s %10s %5s", "PEER", "RTT", "CONN", "RATE", "FPS")}
+
+	var stats map[string]rvt.PeerStats
+	if ps, ok := app.GetScreen().(PeerStatser); ok {
+		stats = ps.PeerStats()
+	}
+	ids := make([]string, 0, len(stats))
+	for id := range stats {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	for _, id := range ids {
+		st := stats[id]
+		rtt, conn := "-", "-"
+		if st.RTT > 0 {
+			rtt = st.RTT.Round(time.Millisecond).String()
+		}
+		if st.Conn != "" {
+			conn = st.Conn
+		}
+		lines = append(lines, fmt.Sprintf("%-14s %7s %-12s %10s %5.1f", shortID(id), rtt, conn, formatRate(st.BytesPerSec), st.FramesPerSec))
+	}
+	if len(ids) == 0 {
+		lines = append(lines, "no peers attached")
+	}
+
+	width := 0
+	for _, line := range lines {
+		if n := len([]rune(line)); n > width {
+			width = n
+		}
+	}
+	width += 2
+	x0, y0 := (cols-width)/2, (rows-len(lines))/2
+	if x0 < 0 {
+		x0 = 0
+	}
+	if y0 < 0 {
+		y0 = 0
+	}
+
+	for i, line := range lines {
+		y := y0 + i
+		if y >= rows {
+			return
+		}
+		style := gowid.StyleNone
+		if i == 0 {
+			style = gowid.StyleBold
+		}
+		text := []rune(" " + line + strings.Repeat(" ", width))
+		for x := 0; x < width && x0+x < cols; x++ {
+			canvas.SetCellAt(x0+x, y, gowid.MakeCell(text[x], gowid.ColorBlack, gowid.ColorGreen, style))
+		}
+	}
+}
+
+// shortID shortens a peer ID to its first and last few characters.
+func shortID(id string) string {
+	if len(id) <= 14 {
+		return id
+	}
+	return id[:6] + "…" + id[len(id)-6:]
+}
+
+func formatRate(bps float64) string {
+	switch {
+	case bps >= 1<<20:
+		return fmt.Sprintf("%.1f MB/s", bps/(1<<20))
+	case bps >= 1<<10:
+		return fmt.Sprintf("%.1f KB/s", bps/(1<<10))
+	}
+	return fmt.Sprintf("%.0f B/s", bps)
+}
+
+func (w *Widget) UserInput(ev interface{}, size gowid.IRenderSize, focus gowid.Selector, app gowid.IApp) bool {
+	evr, ok := ev.(*rvt.RemoteEvent)
+	if !ok {
+		return w.userInput(ev, size, focus, app)
+	}
+	a, ok := app.GetScreen().(Auditor)
+	if !ok {
+		return w.userInput(ev, size, focus, app)
+	}
+
+	// Keys go to the pane focused before the event, while a click may focus
+	// the pane it lands on.
+	target := w.Window(evr.ID).FocusedPane(evr.ID)
+	handled := w.userInput(ev, size, focus, app)
+	switch evt := evr.Event.(type) {
+	case *tcell.EventMouse:
+		target = w.Window(evr.ID).FocusedPane(evr.ID)
+	case *rvt.SelectPaneEvent:
+		target, _ = w.PaneByID(evt.Pane)
+	}
+	a.Audit(evr, target)
+	return handled
+}
+
+func (w *Widget) userInput(ev interface{}, size gowid.IRenderSize, focus gowid.Selector, app gowid.IApp) bool {
+	box, ok := size.(gowid.IRenderBox)
+	if !ok {
+		panic(gowid.WidgetSizeError{Widget: w, Size: size, Required: "gowid.IRenderBox"})
+	}
+	cols, rows := box.BoxColumns(), box.BoxRows()
+
+	evt := ev
+	id := w.defaultID
+	if evr, ok := ev.(*rvt.RemoteEvent); ok {
+		evt = evr.Event
+		id = evr.ID
+	}
+
+	if evs, ok := evt.(*rvt.SelectPaneEvent); ok {
+		if p, _ := w.PaneByID(evs.Pane); p != nil {
+			w.SelectPane(id, p, app)
+		}
+		return true
+	}
+
+	// The status line doesn't take mouse input.
+	if evm, ok := evt.(*tcell.EventMouse); ok {
+		if _, y := evm.Position(); y >= rows-1 {
+			return false
+		}
+	}
+
+	win := w.Window(id)
+	if rows > 1 && win.UserInput(ev, gowid.RenderBox{C: cols, R: rows - 1}, focus, app) {
+		return true
+	}
+
+	handled := false
+	if evk, ok := evt.(*tcell.EventKey); ok && evk.Key() == tcell.KeyRune {
+		handled = true
+		switch evk.Rune() {
+		case 'c':
+			w.NewWindow(id)
+		case 'n':
+			w.NextWindow(id, 1)
+		case 'p':
+			w.NextWindow(id, -1)
+		case '!':
+			w.BreakPane(id, win.FocusedPane(id), app)
+		case 'R':
+			if r, ok := app.GetScreen().(Recorder); ok {
+				r.ToggleRecording(app)
+			}
+		case 'i':
+			w.ToggleInfo(id, app)
+		default:
+			handled = false
+		}
+	}
+	return handled
+}
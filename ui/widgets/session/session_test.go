@@ -0,0 +1,144 @@
+package session
+
+import (
+	"testing"
+
+	"github.com/gcla/gowid"
+	"github.com/hinshun/ptmux/ui/wid"
+	"github.com/hinshun/ptmux/ui/widgets/pane"
+)
+
+var peers = []string{"host", "alice", "bob"}
+
+// testApp is an app with peers attached. Nothing in these tests should reach
+// the gowid app itself.
+func testApp() gowid.IApp {
+	palette := make(map[string]gowid.ICellStyler)
+	for _, id := range peers {
+		palette[id] = nil
+	}
+	return wid.WithP2PContext(struct{ gowid.IApp }{}, palette, gowid.ClickTargets{}, gowid.MouseState{}, gowid.MouseState{})
+}
+
+// checkFocus checks that every focus index in every window is within the
+// sub-widgets of its columns or pile, and that each peer has the expected
+// pane focused in the window it is viewing.
+func checkFocus(t *testing.T, step string, sess *Widget, want map[string]*pane.Widget) {
+	t.Helper()
+
+	var walk func(w gowid.IWidget)
+	walk = func(w gowid.IWidget) {
+		if _, ok := w.(*pane.Widget); ok {
+			return
+		}
+		if f, ok := w.(wid.IFocus); ok {
+			n := len(w.(gowid.ICompositeMultiple).SubWidgets())
+			for _, id := range peers {
+				if i := f.Focus(id); i < 0 || i >= n {
+					t.Errorf("%s: %s focused on child %d of %d in %v", step, id, i, n, w)
+				}
+			}
+		}
+		switch cw := w.(type) {
+		case gowid.ICompositeMultiple:
+			for _, sub := range cw.SubWidgets() {
+				walk(sub)
+			}
+		case gowid.IComposite:
+			walk(cw.SubWidget())
+		}
+	}
+	for _, win := range sess.Windows() {
+		walk(win.SubWidget())
+	}
+	if t.Failed() {
+		t.FailNow()
+	}
+
+	for id, p := range want {
+		got := sess.Window(id).FocusedPane(id)
+		if got != p {
+			t.Errorf("%s: %s focused on %v, expected %v", step, id, got, p)
+		}
+	}
+}
+
+func TestFocusAfterRearranging(t *testing.T) {
+	app := testApp()
+	sess := New("host")
+	win := sess.Window("host")
+
+	p0 := win.FocusedPane("host")
+	win.VerticalSplit("host", p0, app)
+	p1 := win.FocusedPane("host")
+	win.HorizontalSplit("host", p1, app)
+	p2 := win.FocusedPane("host")
+	if p0 == p1 || p1 == p2 || p0 == p2 {
+		t.Fatal("expected three panes")
+	}
+
+	sess.SelectPane("host", p0, app)
+	sess.SelectPane("alice", p1, app)
+	sess.SelectPane("bob", p2, app)
+	want := map[string]*pane.Widget{"host": p0, "alice": p1, "bob": p2}
+	checkFocus(t, "split", sess, want)
+
+	// Focus follows each pane as panes move within a window.
+	win.RotatePanes("host", app)
+	checkFocus(t, "rotate", sess, want)
+	if got := win.Panes(); got[0] != p2 || got[1] != p0 || got[2] != p1 {
+		t.Fatalf("rotate: unexpected order %v", got)
+	}
+
+	err := sess.SwapPanes("host", p0, p2, app)
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkFocus(t, "swap", sess, want)
+	if got := win.Panes(); got[0] != p0 || got[1] != p2 {
+		t.Fatalf("swap: unexpected order %v", got)
+	}
+
+	// Breaking out the pane bob is focused on leaves bob focused on a pane
+	// still in the window.
+	sess.BreakPane("host", p2, app)
+	if len(sess.Windows()) != 2 || !sess.Windows()[1].Contains(p2) || win.Contains(p2) {
+		t.Fatal("break: expected the pane in a window of its own")
+	}
+	want["host"] = p2
+	delete(want, "bob")
+	checkFocus(t, "break", sess, want)
+	if p := win.FocusedPane("bob"); p != p0 && p != p1 {
+		t.Fatalf("break: bob focused on %v", p)
+	}
+
+	// Joining it back closes its window and focuses the peer that joined it.
+	err = sess.JoinPane("host", p2, p1, true, app)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(sess.Windows()) != 1 || !win.Contains(p2) {
+		t.Fatal("join: expected the pane back in the first window")
+	}
+	checkFocus(t, "join", sess, want)
+
+	err = sess.JoinPane("host", p2, p0, false, app)
+	if err == nil {
+		t.Fatal("join: expected an error joining a pane to its own window")
+	}
+
+	// Across windows, peers stay focused on the position of the swapped
+	// pane.
+	other := sess.NewWindow("bob")
+	p3 := other.FocusedPane("bob")
+	err = sess.SwapPanes("host", p1, p3, app)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !win.Contains(p3) || !other.Contains(p1) || win.Contains(p1) || other.Contains(p3) {
+		t.Fatal("swap across windows: panes not exchanged")
+	}
+	want["alice"] = p3
+	want["bob"] = p1
+	checkFocus(t, "swap across windows", sess, want)
+}
@@ -5,6 +5,7 @@ import (
 	"io"
 	"os"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/gcla/gowid"
@@ -19,7 +20,12 @@ import (
 )
 
 type TitleChanged struct{}
+type ProcessStarted struct{}
 type ProcessExited struct{}
+type Output struct{}
+type Bell struct{}
+type Clipboard struct{}
+type Input struct{}
 
 type IWidget interface {
 	io.Writer
@@ -42,6 +48,7 @@ type Widget struct {
 	Callbacks         *gowid.Callbacks
 	terminfo          *terminfo.Terminfo
 	vt                *vt.VT
+	command           vt.Command
 	canvas            *Canvas
 	width, height     int
 	title             string
@@ -50,10 +57,22 @@ type Widget struct {
 	hotKeyDownTime    time.Time
 	hotKeyTimer       *time.Timer
 	isScrolling       bool
+	exited            bool
+	zoomed            bool
+
+	// cursorMark is the name of the canvas mark kept at the cursor while it
+	// is shown.
+	cursorMark string
+
+	// replay is read instead of starting a process, at the size of
+	// replayCols by replayRows.
+	replay                 io.Reader
+	resizeMu               sync.Mutex
+	replayCols, replayRows int
 	gowid.IsSelectable
 }
 
-func New(defaultID, lastID string) (*Widget, error) {
+func New(defaultID, lastID string, command vt.Command) (*Widget, error) {
 	var term string
 	for _, s := range os.Environ() {
 		if strings.HasPrefix(s, "TERM=") {
@@ -82,11 +101,34 @@ func New(defaultID, lastID string) (*Widget, error) {
 		IHotKeyPersistence: gowidterminal.HotKeyDuration{time.Second},
 		Callbacks:          gowid.NewCallbacks(),
 		terminfo:           ti,
+		command:            command,
 		defaultID:          defaultID,
 		lastID:             lastID,
 	}, nil
 }
 
+// NewReplay returns a terminal that displays what is read from r, such as a
+// recording being played back, at a size of cols by rows regardless of the
+// size it is rendered at. Input typed into it is discarded.
+func NewReplay(defaultID string, r io.Reader, cols, rows int) (*Widget, error) {
+	w, err := New(defaultID, defaultID, vt.Command{})
+	if err != nil {
+		return nil, err
+	}
+	w.replay = r
+	w.replayCols, w.replayRows = cols, rows
+	return w, nil
+}
+
+// ResizeReplay changes the size of a replaying terminal, such as when the
+// recording being played back was resized. It is safe to call outside the
+// main loop, and takes effect on the next render.
+func (w *Widget) ResizeReplay(cols, rows int) {
+	w.resizeMu.Lock()
+	w.replayCols, w.replayRows = cols, rows
+	w.resizeMu.Unlock()
+}
+
 func (w *Widget) String() string {
 	return "terminal"
 }
@@ -95,6 +137,95 @@ func (w *Widget) Write(p []byte) (n int, err error) {
 	return w.vt.Write(p)
 }
 
+// Kill hangs up the process running in the terminal.
+func (w *Widget) Kill() error {
+	if !w.Connected() {
+		return nil
+	}
+	return w.vt.Kill()
+}
+
+// Exited returns true if the process running in the terminal has exited.
+func (w *Widget) Exited() bool {
+	return w.exited
+}
+
+// ExitStatus returns the exit status of the process. It is only valid after
+// the ProcessExited callbacks have run.
+func (w *Widget) ExitStatus() vt.ExitStatus {
+	return w.vt.ExitStatus()
+}
+
+// Capture returns the contents of the terminal as text. See vt.VT.Capture.
+func (w *Widget) Capture(escapes, history bool) string {
+	if !w.Connected() {
+		return ""
+	}
+	return w.vt.Capture(escapes, history)
+}
+
+// Size returns the size of the terminal, or zero if it hasn't been rendered
+// yet.
+func (w *Widget) Size() (cols, rows int) {
+	if !w.Connected() {
+		return 0, 0
+	}
+	w.vt.Lock()
+	defer w.vt.Unlock()
+	return w.vt.Size()
+}
+
+// SubscribeOutput sends the output of the process to ch. It returns false if
+// the process hasn't been started yet. See vt.VT.SubscribeOutput.
+func (w *Widget) SubscribeOutput(id string, ch chan string) bool {
+	if !w.Connected() {
+		return false
+	}
+	w.vt.SubscribeOutput(id, ch)
+	return true
+}
+
+// Pipe writes the output of the process to out. It returns false if the
+// process hasn't been started yet. See vt.VT.Pipe.
+func (w *Widget) Pipe(out io.WriteCloser, strip bool) bool {
+	if !w.Connected() {
+		return false
+	}
+	w.vt.Pipe(out, strip)
+	return true
+}
+
+func (w *Widget) UnsubscribeOutput(id string) {
+	if w.Connected() {
+		w.vt.UnsubscribeOutput(id)
+	}
+}
+
+// HidesInput returns true if the terminal is reading input it doesn't echo.
+// See vt.VT.HidesInput.
+func (w *Widget) HidesInput() bool {
+	if !w.Connected() || w.exited {
+		return false
+	}
+	return w.vt.HidesInput()
+}
+
+// Command returns the command the terminal was started with.
+func (w *Widget) Command() vt.Command {
+	return w.command
+}
+
+// Cwd returns the working directory of the process running in the terminal,
+// or the directory it will be started in if it hasn't started yet.
+func (w *Widget) Cwd() string {
+	if w.Connected() && !w.exited {
+		if cwd, err := w.vt.Cwd(); err == nil {
+			return cwd
+		}
+	}
+	return w.command.Dir
+}
+
 func (w *Widget) MouseSupport() gowidterminal.IMouseSupport {
 	return &mouseSupport{w.vt.Mode()}
 }
@@ -146,24 +277,92 @@ func (w *Widget) Scrolling() bool {
 	return w.isScrolling
 }
 
+// SetZoomed pins the size of the terminal to the size it is rendered with
+// while zoomed. Renders of the unzoomed layout are cropped rather than
+// resizing the pty underneath the peers that zoomed it.
+func (w *Widget) SetZoomed(zoomed bool) {
+	w.zoomed = zoomed
+}
+
 func (w *Widget) OnTitleChanged(f gowid.IWidgetChangedCallback) {
 	gowid.AddWidgetCallback(w.Callbacks, TitleChanged{}, f)
 }
 
+func (w *Widget) OnProcessStarted(f gowid.IWidgetChangedCallback) {
+	gowid.AddWidgetCallback(w.Callbacks, ProcessStarted{}, f)
+}
+
+func (w *Widget) OnOutput(f gowid.IWidgetChangedCallback) {
+	gowid.AddWidgetCallback(w.Callbacks, Output{}, f)
+}
+
+func (w *Widget) OnBell(f gowid.IWidgetChangedCallback) {
+	gowid.AddWidgetCallback(w.Callbacks, Bell{}, f)
+}
+
+// OnClipboard registers a callback for the process setting the clipboard. It
+// is called with the data set.
+func (w *Widget) OnClipboard(f gowid.IWidgetChangedCallback) {
+	gowid.AddWidgetCallback(w.Callbacks, Clipboard{}, f)
+}
+
+// OnInput registers a callback for input typed into the terminal by a peer.
+// It is called with the ID of the peer and the bytes written to the pty.
+func (w *Widget) OnInput(f gowid.IWidgetChangedCallback) {
+	gowid.AddWidgetCallback(w.Callbacks, Input{}, f)
+}
+
+func (w *Widget) RemoveOnInput(f gowid.IIdentity) {
+	gowid.RemoveWidgetCallback(w.Callbacks, Input{}, f)
+}
+
 func (w *Widget) OnProcessExited(f gowid.IWidgetChangedCallback) {
 	gowid.AddWidgetCallback(w.Callbacks, ProcessExited{}, f)
 }
 
+func (w *Widget) RemoveOnProcessExited(f gowid.IIdentity) {
+	gowid.RemoveWidgetCallback(w.Callbacks, ProcessExited{}, f)
+}
+
 func (w *Widget) Render(size gowid.IRenderSize, focus gowid.Selector, app gowid.IApp) gowid.ICanvas {
 	box, ok := size.(gowid.IRenderBox)
 	if !ok {
 		panic(gowid.WidgetSizeError{Widget: w, Size: size, Required: "gowid.IRenderBox"})
 	}
 
+	if w.replay != nil {
+		w.resizeMu.Lock()
+		cols, rows := w.replayCols, w.replayRows
+		w.resizeMu.Unlock()
+		return w.renderCropped(box, cols, rows, app)
+	}
+
+	if w.zoomed && !wid.Zoomed(app) && w.Connected() {
+		return w.renderCropped(box, w.width, w.height, app)
+	}
+
 	w.TouchTerminal(box.BoxColumns(), box.BoxRows(), app)
 	return w.canvas
 }
 
+// renderCropped renders the terminal at a size of width by height, cropped or
+// padded to fit box.
+func (w *Widget) renderCropped(box gowid.IRenderBox, width, height int, app gowid.IApp) gowid.ICanvas {
+	w.TouchTerminal(width, height, app)
+
+	cols, rows := box.BoxColumns(), box.BoxRows()
+	canvas := gowid.NewCanvasOfSize(cols, rows)
+	for y := 0; y < rows && y < w.canvas.BoxRows(); y++ {
+		for x := 0; x < cols && x < w.canvas.BoxColumns(); x++ {
+			canvas.SetCellAt(x, y, w.canvas.CellAt(x, y))
+		}
+	}
+	if pos, ok := w.canvas.GetMark(w.cursorMark); ok && pos.X < cols && pos.Y < rows {
+		canvas.SetMark(w.cursorMark, pos.X, pos.Y)
+	}
+	return canvas
+}
+
 func (w *Widget) RenderSize(size gowid.IRenderSize, focus gowid.Selector, app gowid.IApp) gowid.IRenderBox {
 	box, ok := size.(gowid.IRenderBox)
 	if !ok {
@@ -179,29 +378,56 @@ func (w *Widget) TouchTerminal(width, height int, app gowid.IApp) {
 
 	setTermSize := false
 	if !w.Connected() {
-		var err error
-		w.vt, err = vt.New(width, height)
-		if err != nil {
-			panic(err)
+		if w.replay != nil {
+			w.vt = vt.Replay(width, height, w.replay)
+		} else {
+			var err error
+			w.vt, err = vt.New(width, height, w.command)
+			if err != nil {
+				panic(err)
+			}
 		}
 
 		renderCh := make(chan string, 1)
 		w.vt.Subscribe("host", renderCh)
+		bellCh := make(chan string, 1)
+		w.vt.SubscribeBell("host", bellCh)
+		clipboardCh := make(chan string, 1)
+		w.vt.SubscribeClipboard("host", clipboardCh)
 
 		go func() {
 			for {
 				select {
 				case <-w.vt.Done():
+					status := w.vt.ExitStatus()
 					app.Run(gowid.RunFunction(func(app gowid.IApp) {
-						gowid.RunWidgetCallbacks(w.Callbacks, ProcessExited{}, app, w, w.lastID)
+						w.exited = true
+						gowid.RunWidgetCallbacks(w.Callbacks, ProcessExited{}, app, w, w.lastID, status)
 					}))
 					return
+				case _, ok := <-bellCh:
+					if !ok {
+						bellCh = nil
+						continue
+					}
+					app.Run(gowid.RunFunction(func(app gowid.IApp) {
+						gowid.RunWidgetCallbacks(w.Callbacks, Bell{}, app, w)
+					}))
+				case data, ok := <-clipboardCh:
+					if !ok {
+						clipboardCh = nil
+						continue
+					}
+					app.Run(gowid.RunFunction(func(app gowid.IApp) {
+						gowid.RunWidgetCallbacks(w.Callbacks, Clipboard{}, app, w, []byte(data))
+					}))
 				case <-renderCh:
 					app.Run(gowid.RunFunction(func(runApp gowid.IApp) {
 						w.vt.Lock()
 						cols, rows := w.vt.Size()
 						w.RenderTerminal(cols, rows, app)
 						w.vt.Unlock()
+						gowid.RunWidgetCallbacks(w.Callbacks, Output{}, runApp, w)
 						runApp.Redraw()
 					}))
 
@@ -217,6 +443,7 @@ func (w *Widget) TouchTerminal(width, height int, app gowid.IApp) {
 		}()
 
 		setTermSize = true
+		gowid.RunWidgetCallbacks(w.Callbacks, ProcessStarted{}, app, w)
 	}
 
 	if !(w.width == width && w.height == height) {
@@ -261,6 +488,14 @@ func (w *Widget) RenderTerminal(cols, rows int, app gowid.IApp) {
 		}
 	}
 
+	if w.cursorMark != "" {
+		w.canvas.RemoveMark(w.cursorMark)
+		cursor := w.vt.Cursor()
+		if w.vt.CursorVisible() && !w.Scrolling() && cursor.X < cols && cursor.Y < rows {
+			w.canvas.SetMark(w.cursorMark, cursor.X, cursor.Y)
+		}
+	}
+
 	p := app.(wid.IP2PApp)
 	id, palette := p.FocusPalette(w.lastID)
 	if palette != nil && w.vt.CursorVisible() {
@@ -294,6 +529,22 @@ func (w *Widget) RenderTerminal(cols, rows int, app gowid.IApp) {
 	}
 }
 
+// SetCursorMark keeps a canvas mark named name at the cursor while it is
+// shown, so that where the cursor is drawn can be found on the canvases the
+// terminal is rendered onto.
+func (w *Widget) SetCursorMark(name string) {
+	w.cursorMark = name
+}
+
+// LineEditing returns true if the process in the terminal appears to be
+// editing a line of input, so that printable keys are echoed at the cursor.
+func (w *Widget) LineEditing() bool {
+	if !w.Connected() || w.exited || w.replay != nil {
+		return false
+	}
+	return w.vt.LineEditing()
+}
+
 func (w *Widget) Canvas() *Canvas {
 	return w.canvas
 }
@@ -305,7 +556,7 @@ func (w *Widget) Connected() bool {
 func (w *Widget) UserInput(ev interface{}, size gowid.IRenderSize, focus gowid.Selector, app gowid.IApp) bool {
 	handled := false
 	// True if input should be sent to tty.
-	passToTty := true
+	passToTty := !w.exited
 
 	evt := ev
 	id := w.defaultID
@@ -383,6 +634,7 @@ func (w *Widget) UserInput(ev interface{}, size gowid.IRenderSize, focus gowid.S
 			}
 			handled = true
 			w.lastID = id
+			gowid.RunWidgetCallbacks(w.Callbacks, Input{}, app, w, id, seq)
 		}
 	}
 	return handled